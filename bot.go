@@ -2,12 +2,12 @@ package goTelegram
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log"
-	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -18,38 +18,57 @@ import (
 
 // NewBot : Create A New Bot
 func NewBot(s string) (Bot, error) {
+	return NewBotWithOptions(context.Background(), s, BotOptions{})
+}
+
+// NewBotWithOptions : Create A New Bot Using A Custom HTTP Client Or Transport
+func NewBotWithOptions(ctx context.Context, s string, options BotOptions) (Bot, error) {
 
 	var newBot Bot
 
-	newBot.APIURL = "https://api.telegram.org/bot" + s
+	newBot.baseURL = strings.TrimSuffix(options.APIEndpoint, "/")
 
-	newBot.keyboardManager = newKeyboardManager()
+	if newBot.baseURL == "" {
+		newBot.baseURL = DefaultAPIEndpoint
+	}
 
-	resp, err := http.Get(newBot.APIURL + "/getMe")
+	newBot.token = s
+	newBot.localMode = options.LocalMode
+	newBot.APIURL = newBot.baseURL + "/bot" + s
 
-	if err != nil {
-		log.Println("Fetch Bot Details Failed, Check Internet Connection")
-		return newBot, err
-	}
+	newBot.keyboardManager = newKeyboardManager()
+	newBot.webhook = &webhookGuard{}
+	newBot.router = newRouter()
 
-	defer func() { _ = resp.Body.Close() }()
+	newBot.limiter = newRateLimiter(options.RateLimit)
+	newBot.dispatcher = newDispatcher(options.Dispatch)
+	newBot.requests = &requestTracker{}
 
-	if resp.StatusCode != http.StatusOK {
-		log.Println("Invalid Token Provided")
-		return newBot, errors.New("invalid Bot Token Provided")
+	switch {
+	case options.Client != nil:
+		newBot.client = options.Client
+	case options.Transport != nil:
+		newBot.client = &http.Client{Transport: options.Transport}
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&newBot)
+	err := newBot.Call(ctx, "getMe", nil, &newBot.Me)
 
 	if err != nil {
-		log.Println("Couldn't Marshal Response")
-		log.Println(err)
+		if apiErr, ok := asAPIError(err); ok && (apiErr.ErrorCode == http.StatusUnauthorized || apiErr.ErrorCode == http.StatusNotFound) {
+			log.Println("Invalid Token Provided")
+			return newBot, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		}
+
+		log.Println("Fetch Bot Details Failed, Check Internet Connection")
 		return newBot, err
 	}
 
 	return newBot, nil
 }
 
+// CreateKeyboard : Create An InlineKeyboard That Is Attached To Every Message Sent To The Chat Until DeleteKeyboard Is Called
+// This Is An Opt-In Convenience, Prefer Building A Keyboard With NewInlineKeyboard And Passing It To The Message It Belongs To
+// A Reply Keyboard Already Created For The Chat Is Replaced, Calling It Again For An Inline Keyboard Changes Nothing
 func (b *Bot) CreateKeyboard(chatId int, maxColumns ...int) {
 	maxCols := 3
 
@@ -61,18 +80,74 @@ func (b *Bot) CreateKeyboard(chatId int, maxColumns ...int) {
 }
 
 // AddButtons : Add Buttons For InlineKeyboard
+// Nothing Is Added Unless Every Button Has Text And Between 1 And MaxCallbackDataLength Bytes Of Data,
+// Use CallbackData For Larger Payloads
 func (b *Bot) AddButtons(chatID int, buttonData ...string) error {
 	if len(buttonData)%2 != 0 {
 		return errors.New("invalid Number Of Parameters Passed, It Should Be In Teh Format (buttonData, data, buttonData, data)")
 	}
 
+	buttons := make([]InlineKeyboard, 0, len(buttonData)/2)
+
 	for i := 0; i < len(buttonData); i += 2 {
-		b.keyboardManager.AddButton(chatID, buttonData[i], buttonData[i+1])
+		button := InlineKeyboard{Text: buttonData[i], Data: buttonData[i+1]}
+
+		if err := button.Validate(); err != nil {
+			return err
+		}
+
+		buttons = append(buttons, button)
+	}
+
+	for _, button := range buttons {
+		b.keyboardManager.AddButton(chatID, button.Text, button.Data)
 	}
 
 	return nil
 }
 
+// AddInlineButtons : Add Buttons Of Any Kind To The Chat's InlineKeyboard
+// Nothing Is Added Unless Every Button Is Valid
+func (b *Bot) AddInlineButtons(chatID int, buttons ...InlineKeyboard) error {
+	for _, button := range buttons {
+		if err := button.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, button := range buttons {
+		b.keyboardManager.AddInlineButton(chatID, button)
+	}
+
+	return nil
+}
+
+// CreateReplyKeyboard : Create A Reply Keyboard, Shown In Place Of The User's Keyboard, For The Given Chat
+// Buttons Are Added With AddReplyButtons And Laid Out In Rows Of maxColumns, Which Defaults To 3
+// An Inline Keyboard Already Created For The Chat Is Replaced, Calling It Again For A Reply Keyboard Changes Nothing
+func (b *Bot) CreateReplyKeyboard(chatID int, options ReplyKeyboardOptions, maxColumns ...int) {
+	maxCols := 3
+
+	if len(maxColumns) > 0 {
+		maxCols = maxColumns[0]
+	}
+
+	b.keyboardManager.CreateReplyKeyboard(chatID, maxCols, options)
+}
+
+// AddReplyButtons : Add Buttons To The Chat's Reply Keyboard
+func (b *Bot) AddReplyButtons(chatID int, buttons ...KeyboardButton) {
+	for _, button := range buttons {
+		b.keyboardManager.AddReplyButton(chatID, button)
+	}
+}
+
+// SetReplyMarkup : Attach markup To Every Message Sent To The Chat Until DeleteKeyboard Is Called
+// It Takes Priority Over Any Buttons Added To The Chat's Keyboard, Use MessageOptions.ReplyMarkup For A Single Message
+func (b *Bot) SetReplyMarkup(chatID int, markup ReplyMarkup) {
+	b.keyboardManager.SetMarkup(chatID, markup)
+}
+
 // DeleteKeyboard : Delete Current Keyboard
 func (b *Bot) DeleteKeyboard(chatID int) {
 	b.keyboardManager.DeleteKeyboard(chatID)
@@ -86,12 +161,32 @@ func (b *Bot) SetHandler(fn func(Update)) bool {
 }
 
 // UpdateHandler : Handles New Updates From Telegram
-func (b *Bot) UpdateHandler(_ http.ResponseWriter, r *http.Request) {
+func (b *Bot) UpdateHandler(w http.ResponseWriter, r *http.Request) {
 
-	if b.handlerSet {
+	if !b.verifyRequest(r) {
+		log.Println("Rejected Webhook Request With Missing Or Invalid Secret Token")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !b.allowedSource(r) {
+		log.Println("Rejected Webhook Request From Unknown Source", r.RemoteAddr)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if b.dispatcher == nil {
+		b.dispatcher = newDispatcher(DispatchOptions{})
+	}
+
+	if b.dispatcher.isClosed() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	if b.hasHandler() {
 
 		var update Update
-		var text []string
 
 		err := json.NewDecoder(r.Body).Decode(&update)
 
@@ -100,59 +195,146 @@ func (b *Bot) UpdateHandler(_ http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		switch {
-		case len(update.EditedMessage.Text) > 0:
-			update.Type = "edited_text"
-			text = strings.Fields(update.EditedMessage.Text)
+		b.classifyUpdate(&update)
+		b.resolveCallback(r.Context(), &update)
 
-		case len(update.Message.Text) > 0:
-			update.Type = "text"
-			text = strings.Fields(update.Message.Text)
+		// Telegram Waits On This Response, So A Full Queue Is Only Waited On Briefly Before Asking It To Retry
+		ctx, cancel := context.WithTimeout(r.Context(), b.dispatcher.options.WebhookWait)
+		err = b.dispatch(ctx, update)
+		cancel()
 
-		case len(update.CallbackQuery.ID) > 0:
-			update.Type = "callback"
+		if err != nil {
+			log.Println("Couldn't Accept Update, Asking Telegram To Retry Later:", err)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	} else {
+		log.Println("Please Set A Function To Be Called Upon New Updates")
+		return
+	}
 
-		case len(update.Message.File.FileName) > 0:
-			update.Type = "document"
+}
 
-		case len(update.Message.Photo) > 0 && len(update.Message.Video.FileID) > 0:
-			update.Type = "media_group"
+// classifyUpdate : Fill In The Type And Command Of An Incoming Update
+func (b *Bot) classifyUpdate(update *Update) {
+	var text []string
 
-		case len(update.Message.Photo) > 0:
-			update.Type = "photo"
+	switch {
+	case len(update.EditedMessage.Text) > 0:
+		update.Type = UpdateEditedText
+		text = strings.Fields(update.EditedMessage.Text)
 
-		case len(update.Message.Video.FileID) > 0:
-			update.Type = "video"
+	case len(update.Message.Text) > 0:
+		update.Type = UpdateText
+		text = strings.Fields(update.Message.Text)
 
-		default:
-			update.Type = "unknown"
-		}
+	case len(update.CallbackQuery.ID) > 0:
+		update.Type = UpdateCallback
 
-		if len(text) > 0 {
+	case len(update.Message.File.FileName) > 0:
+		update.Type = UpdateDocument
 
-			if strings.HasPrefix(text[0], "/") {
+	case len(update.Message.Photo) > 0 && len(update.Message.Video.FileID) > 0:
+		update.Type = UpdateMediaGroup
 
-				update.Command = text[0]
+	case len(update.Message.Photo) > 0:
+		update.Type = UpdatePhoto
 
-				if strings.HasSuffix(text[0], b.Me.Username) {
-					update.Command = strings.Split(text[0], "@")[0]
-				}
+	case len(update.Message.Video.FileID) > 0:
+		update.Type = UpdateVideo
 
-			}
-		}
+	case update.Message.MessageID != 0:
+		update.Type = UpdateMessage
 
-		go b.handler(update)
-	} else {
-		log.Println("Please Set A Function To Be Called Upon New Updates")
-		return
+	case update.EditedMessage.MessageID != 0:
+		update.Type = UpdateEditedMessage
+
+	case update.ChannelPost != nil:
+		update.Type = UpdateChannelPost
+
+	case update.EditedChannelPost != nil:
+		update.Type = UpdateEditedChannelPost
+
+	case update.BusinessConnection != nil:
+		update.Type = UpdateBusinessConnection
+
+	case update.BusinessMessage != nil:
+		update.Type = UpdateBusinessMessage
+
+	case update.EditedBusinessMessage != nil:
+		update.Type = UpdateEditedBusinessMessage
+
+	case update.DeletedBusinessMessages != nil:
+		update.Type = UpdateDeletedBusinessMessages
+
+	case update.MessageReaction != nil:
+		update.Type = UpdateMessageReaction
+
+	case update.MessageReactionCount != nil:
+		update.Type = UpdateMessageReactionCount
+
+	case update.InlineQuery != nil:
+		update.Type = UpdateInlineQuery
+
+	case update.ChosenInlineResult != nil:
+		update.Type = UpdateChosenInlineResult
+
+	case update.ShippingQuery != nil:
+		update.Type = UpdateShippingQuery
+
+	case update.PreCheckoutQuery != nil:
+		update.Type = UpdatePreCheckoutQuery
+
+	case update.PurchasedPaidMedia != nil:
+		update.Type = UpdatePurchasedPaidMedia
+
+	case update.Poll != nil:
+		update.Type = UpdatePoll
+
+	case update.PollAnswer != nil:
+		update.Type = UpdatePollAnswer
+
+	case update.MyChatMember != nil:
+		update.Type = UpdateMyChatMember
+
+	case update.ChatMember != nil:
+		update.Type = UpdateChatMember
+
+	case update.ChatJoinRequest != nil:
+		update.Type = UpdateChatJoinRequest
+
+	case update.ChatBoost != nil:
+		update.Type = UpdateChatBoost
+
+	case update.RemovedChatBoost != nil:
+		update.Type = UpdateRemovedChatBoost
+
+	default:
+		update.Type = UpdateUnknown
 	}
 
+	if len(text) > 0 {
+
+		if strings.HasPrefix(text[0], "/") {
+
+			update.Command = text[0]
+			update.Args = text[1:]
+
+			if strings.HasSuffix(text[0], b.Me.Username) {
+				update.Command = strings.Split(text[0], "@")[0]
+			}
+
+		}
+	}
 }
 
 // AnswerCallback : Answer Call Back Query From InlineKeyboard
 func (b *Bot) AnswerCallback(callbackID, text string, showAlert bool) error {
-	link := b.APIURL + "/answerCallbackQuery"
+	return b.AnswerCallbackCtx(context.Background(), callbackID, text, showAlert)
+}
 
+// AnswerCallbackCtx : AnswerCallback With A Context
+func (b *Bot) AnswerCallbackCtx(ctx context.Context, callbackID, text string, showAlert bool) error {
 	answer := answerCallback{
 		ID: callbackID,
 	}
@@ -167,152 +349,106 @@ func (b *Bot) AnswerCallback(callbackID, text string, showAlert bool) error {
 		answer.ShowAlert = "false"
 	}
 
-	jsonBody, err := json.Marshal(answer)
-
-	if err != nil {
-		return err
-	}
-
-	resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+	err := b.Call(ctx, "answerCallbackQuery", answer, nil)
 
 	if err != nil {
-		log.Println("Couldn't Answer CallBack Successfully, Check Internet Source")
+		log.Println("Couldn't Answer CallBack Successfully")
 		return err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Println("Couldn't Answer CallBack Successfully, Status Code Not OK")
-		return errors.New(string(body))
-	}
-
 	return nil
 }
 
 // SendMessage : Send A Message To A User
 func (b *Bot) SendMessage(s string, c Chat) (Message, error) {
+	return b.SendMessageWithOptionsCtx(context.Background(), s, c, MessageOptions{})
+}
 
-	link := b.APIURL + "/sendMessage"
-
-	reply := replyBody{
-		ChatID: strconv.Itoa(c.ID),
-		Text:   s,
-	}
-
-	if b.keyboardManager.HasKeyboard(c.ID) {
-		reply.ReplyMarkup.InlineKeyboard = b.keyboardManager.ReturnKeyboard(c.ID)
-	}
-
-	jsonBody, err := json.Marshal(reply)
-
-	if err != nil { // _, err := bot.SendMessage("Hello "+update.Message.From.Firstname, update.Message.Chat)
-		//
-		// if err != nil {
-		// 	log.Println(err)
-		// }
-		log.Println("Couldn't Marshal Response")
-		return Message{}, err
-
-	}
-
-	resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+// SendMessageCtx : SendMessage With A Context
+func (b *Bot) SendMessageCtx(ctx context.Context, s string, c Chat) (Message, error) {
+	return b.SendMessageWithOptionsCtx(ctx, s, c, MessageOptions{})
+}
 
-	if err != nil {
-		body, _ := io.ReadAll(resp.Body)
-		log.Println("Couldn't Make Request Successfully, Please Check Internet Source")
-		return Message{}, errors.New(string(body))
-	}
+// SendMessageWithOptions : Send A Message To A User, Controlling Formatting, Notifications, Replies And Markup
+func (b *Bot) SendMessageWithOptions(s string, c Chat, options MessageOptions) (Message, error) {
+	return b.SendMessageWithOptionsCtx(context.Background(), s, c, options)
+}
 
-	defer func() { _ = resp.Body.Close() }()
+// SendMessageWithOptionsCtx : SendMessageWithOptions With A Context
+func (b *Bot) SendMessageWithOptionsCtx(ctx context.Context, s string, c Chat, options MessageOptions) (Message, error) {
+	return b.sendText(ctx, s, c, options, b.markupFor(c.ID, options.ReplyMarkup))
+}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Println("Message Wasn't Sent Successfully, Please Try Again")
-		return Message{}, errors.New(string(body))
+// sendText : Send s To c With markup Attached As-Is, Ignoring options.ReplyMarkup
+func (b *Bot) sendText(ctx context.Context, s string, c Chat, options MessageOptions, markup ReplyMarkup) (Message, error) {
+	reply := replyBody{
+		ChatID:              strconv.Itoa(c.ID),
+		MessageThreadID:     options.MessageThreadID,
+		Text:                s,
+		ParseMode:           options.ParseMode,
+		Entities:            options.Entities,
+		LinkPreviewOptions:  options.LinkPreviewOptions,
+		DisableNotification: options.DisableNotification,
+		ProtectContent:      options.ProtectContent,
+		ReplyParameters:     options.ReplyParameters,
+		ReplyMarkup:         markup,
 	}
 
-	var response TResponse
 	var newMessage Message
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	err := b.Call(ctx, "sendMessage", reply, &newMessage)
 
 	if err != nil {
+		log.Println("Message Wasn't Sent Successfully, Please Try Again")
 		return Message{}, err
 	}
 
-	newMessage = Message{
-		MessageID: response.Result.MessageId,
-		Chat:      response.Result.Chat,
-		From:      response.Result.From,
-	}
-
 	return newMessage, nil
 }
 
-func (b *Bot) ReplyMessage(s string, m Message) error {
-	link := b.APIURL + "/sendMessage"
-
-	reply := replyBody{
-		ChatID: strconv.Itoa(m.Chat.ID),
-		Text:   s,
-		ReplyParameters: replyParameters{
-			MessageID: m.MessageID,
-		},
-	}
-
-	if b.keyboardManager.HasKeyboard(m.Chat.ID) {
-		reply.ReplyMarkup.InlineKeyboard = b.keyboardManager.ReturnKeyboard(m.Chat.ID)
-	}
-
-	jsonBody, err := json.Marshal(reply)
+// ReplyMessage : Send A Message As A Reply To m
+func (b *Bot) ReplyMessage(s string, m Message) (Message, error) {
+	return b.ReplyMessageWithOptionsCtx(context.Background(), s, m, MessageOptions{})
+}
 
-	if err != nil {
-		log.Println("Couldn't Marshal Response")
-		return err
-	}
+// ReplyMessageCtx : ReplyMessage With A Context
+func (b *Bot) ReplyMessageCtx(ctx context.Context, s string, m Message) (Message, error) {
+	return b.ReplyMessageWithOptionsCtx(ctx, s, m, MessageOptions{})
+}
 
-	resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+// ReplyMessageWithOptions : ReplyMessage With The Same Options As SendMessageWithOptions
+// options.ReplyParameters Can Be Set To Quote Part Of m
+func (b *Bot) ReplyMessageWithOptions(s string, m Message, options MessageOptions) (Message, error) {
+	return b.ReplyMessageWithOptionsCtx(context.Background(), s, m, options)
+}
 
-	if err != nil {
-		body, _ := io.ReadAll(resp.Body)
-		log.Println("Couldn't Make Request Successfully, Please Check Internet Source")
-		return errors.New(string(body))
+// ReplyMessageWithOptionsCtx : ReplyMessageWithOptions With A Context
+func (b *Bot) ReplyMessageWithOptionsCtx(ctx context.Context, s string, m Message, options MessageOptions) (Message, error) {
+	if options.ReplyParameters == nil {
+		options.ReplyParameters = &ReplyParameters{MessageID: m.MessageID}
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Println("Message Wasn't Sent Successfully, Please Try Again")
-		return errors.New(string(body))
+	if options.MessageThreadID == 0 && m.IsTopicMessage {
+		options.MessageThreadID = m.MessageThreadID
 	}
 
-	return nil
+	return b.SendMessageWithOptionsCtx(ctx, s, m.Chat, options)
 }
 
+// DownloadFile : Save The File With The Given ID To filename
 func (b *Bot) DownloadFile(fileId, filename string) error {
-	splitLink := strings.Split(b.APIURL, "bot")
-
-	fileDetails, err := b.fetchFileDetails(fileId)
-	if err != nil {
-		return err
-	}
+	return b.DownloadFileCtx(context.Background(), fileId, filename)
+}
 
-	file := fileDetails.File
-	resp, err := http.Get(splitLink[0] + "/file/bot" + splitLink[1] + "/" + file.FilePath)
+// DownloadFileCtx : DownloadFile With A Context
+func (b *Bot) DownloadFileCtx(ctx context.Context, fileId, filename string) error {
+	resp, err := b.openFile(ctx, fileId)
 
 	if err != nil {
 		return err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
-	}
+	defer func() { _ = resp.Close() }()
 
 	fileName, err := os.Create(filename)
 
@@ -322,7 +458,7 @@ func (b *Bot) DownloadFile(fileId, filename string) error {
 
 	defer func() { _ = fileName.Close() }()
 
-	_, err = io.Copy(fileName, resp.Body)
+	_, err = io.Copy(fileName, resp)
 
 	if err != nil {
 		return err
@@ -331,32 +467,26 @@ func (b *Bot) DownloadFile(fileId, filename string) error {
 	return nil
 }
 
+// DownloadFileToMemory : Fetch The File With The Given ID Into Memory
 func (b *Bot) DownloadFileToMemory(fileId string) ([]byte, error) {
-	buff := new(bytes.Buffer)
-	splitLink := strings.Split(b.APIURL, "bot")
+	return b.DownloadFileToMemoryCtx(context.Background(), fileId)
+}
 
-	fileDetails, err := b.fetchFileDetails(fileId)
-	if err != nil {
-		return nil, err
-	}
+// DownloadFileToMemoryCtx : DownloadFileToMemory With A Context
+func (b *Bot) DownloadFileToMemoryCtx(ctx context.Context, fileId string) ([]byte, error) {
+	buff := new(bytes.Buffer)
 
-	url := fmt.Sprintf("%s/file/bot%s/%s", splitLink[0], splitLink[1], fileDetails.File.FilePath)
+	resp, err := b.openFile(ctx, fileId)
 
-	resp, err := http.Get(url)
 	if err != nil {
 		return nil, err
 	}
 
 	defer func() {
-		_ = resp.Body.Close()
+		_ = resp.Close()
 	}()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, errors.New(string(body))
-	}
-
-	_, err = io.Copy(buff, resp.Body)
+	_, err = io.Copy(buff, resp)
 
 	if err != nil {
 		return nil, err
@@ -367,523 +497,395 @@ func (b *Bot) DownloadFileToMemory(fileId string) ([]byte, error) {
 
 // EditMessage : Edit An Existing Message
 func (b *Bot) EditMessage(m Message, text string) (Message, error) {
+	return b.EditMessageWithOptionsCtx(context.Background(), m, text, MessageOptions{})
+}
 
-	link := b.APIURL + "/editMessageText"
+// EditMessageCtx : EditMessage With A Context
+func (b *Bot) EditMessageCtx(ctx context.Context, m Message, text string) (Message, error) {
+	return b.EditMessageWithOptionsCtx(ctx, m, text, MessageOptions{})
+}
 
-	updatedText := editBody{
-		ChatID:    strconv.Itoa(m.Chat.ID),
-		MessageID: m.MessageID,
-		Text:      text,
-	}
+// EditMessageWithOptions : Edit An Existing Message, Controlling Formatting, Link Previews And Markup
+// Options That Only Apply To New Messages Are Ignored, And Any ReplyMarkup Other Than An Inline Keyboard Is An Error
+func (b *Bot) EditMessageWithOptions(m Message, text string, options MessageOptions) (Message, error) {
+	return b.EditMessageWithOptionsCtx(context.Background(), m, text, options)
+}
 
-	if b.keyboardManager.HasKeyboard(m.Chat.ID) {
-		updatedText.ReplyMarkup.InlineKeyboard = b.keyboardManager.ReturnKeyboard(m.Chat.ID)
+// EditMessageWithOptionsCtx : EditMessageWithOptions With A Context
+func (b *Bot) EditMessageWithOptionsCtx(ctx context.Context, m Message, text string, options MessageOptions) (Message, error) {
+	updatedText := editBody{
+		ChatID:             strconv.Itoa(m.Chat.ID),
+		MessageID:          m.MessageID,
+		Text:               text,
+		ParseMode:          options.ParseMode,
+		Entities:           options.Entities,
+		LinkPreviewOptions: options.LinkPreviewOptions,
+	}
+
+	// Only Inline Keyboards Can Be Attached To An Edited Message, So The Chat's Reply Keyboard Is Left Out
+	switch markup := b.markupFor(m.Chat.ID, options.ReplyMarkup).(type) {
+	case InlineKeyboardMarkup:
+		updatedText.ReplyMarkup = markup
+	case *InlineKeyboardMarkup:
+		updatedText.ReplyMarkup = markup
+	default:
+		if options.ReplyMarkup != nil {
+			return Message{}, errors.New("only An Inline Keyboard Can Be Attached When Editing A Message")
+		}
 	}
 
-	jsonBody, err := json.Marshal(updatedText)
-
-	if err != nil {
-		log.Println("There Was An Error Marshalling The Message")
-		return Message{}, err
-	}
+	var newMessage Message
 
-	resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+	err := b.Call(ctx, "editMessageText", updatedText, &newMessage)
 
 	if err != nil {
-		log.Println("Couldn't Communicate With Telegram Servers, Please Check Internet Source")
-		log.Println(err)
-		body, _ := io.ReadAll(resp.Body)
-		return Message{}, errors.New(string(body))
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
 		log.Println("Message Wasn't Edited Successfully, Please Try Again")
-		return Message{}, errors.New(string(body))
-	}
-
-	var response TResponse
-	var newMessage Message
-
-	err = json.NewDecoder(resp.Body).Decode(&response)
-
-	newMessage = Message{
-		MessageID: response.Result.MessageId,
-		Chat:      response.Result.Chat,
-		From:      response.Result.From,
+		return Message{}, err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
 	return newMessage, nil
 }
 
 // DeleteMessage : Delete The Specified Message
 func (b *Bot) DeleteMessage(message Message) error {
-	link := b.APIURL + "/deleteMessage"
+	return b.DeleteMessageCtx(context.Background(), message)
+}
 
+// DeleteMessageCtx : DeleteMessage With A Context
+func (b *Bot) DeleteMessageCtx(ctx context.Context, message Message) error {
 	deletion := deleteBody{
 		MessageID: message.MessageID,
 		ChatID:    strconv.Itoa(message.Chat.ID),
 	}
 
-	jsonBody, err := json.Marshal(deletion)
-
-	if err != nil {
-		log.Println("There Was An Error Marshalling The Object")
-		return err
-	}
-
-	resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+	err := b.Call(ctx, "deleteMessage", deletion, nil)
 
 	if err != nil {
-		log.Println("Couldn't Complete Message Deletion Request, Please Check Internet Source")
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
-	}
-
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
 		log.Println("Message Wasn't Deleted Successfully, Please Try Again")
-		return errors.New(string(body))
+		return err
 	}
 
 	return nil
 }
 
-func (b *Bot) SendVideoFromMemory(data []byte, caption string, c Chat, options MediaOptions) error {
-	link := b.APIURL + "/sendVideo"
-
-	buffer := bytes.NewReader(data)
-
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-
-	part, err := writer.CreateFormFile("video", "video.mp4")
-	if err != nil {
-		return err
-	}
-
-	_, err = io.Copy(part, buffer)
-	if err != nil {
-		return err
-	}
-
-	_ = writer.WriteField("chat_id", strconv.Itoa(c.ID))
-
-	if caption != "" {
-		_ = writer.WriteField("caption", caption)
-	}
+// SendVideoFromMemory : Upload A Video Held In Memory
+func (b *Bot) SendVideoFromMemory(data []byte, caption string, c Chat, options MediaOptions) (Message, error) {
+	return b.SendVideoFromMemoryCtx(context.Background(), data, caption, c, options)
+}
 
-	if options.UseSpoiler {
-		_ = writer.WriteField("has_spoiler", "true")
+// SendVideoFromMemoryCtx : SendVideoFromMemory With A Context
+func (b *Bot) SendVideoFromMemoryCtx(ctx context.Context, data []byte, caption string, c Chat, options MediaOptions) (Message, error) {
+	if err := checkCaption(caption); err != nil {
+		return Message{}, err
 	}
 
-	if options.ProtectContent {
-		_ = writer.WriteField("protect_content", "true")
+	body := videoBody{
+		ChatID:         strconv.Itoa(c.ID),
+		Caption:        caption,
+		HasSpoiler:     options.UseSpoiler,
+		ProtectContent: options.ProtectContent,
+		ReplyMarkup:    b.markupFor(c.ID, options.ReplyMarkup),
 	}
 
-	_ = writer.Close()
+	var message Message
 
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", link, body)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	resp, err := client.Do(req)
+	err := b.CallMultipart(ctx, "sendVideo", body, []InputFile{{Field: "video", Name: "video.mp4", Reader: bytes.NewReader(data)}}, &message)
 
 	if err != nil {
-		log.Println("Couldn't Send Photo, Check Internet Connection")
-		return err
-	}
-
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		log.Println("Photo Not Sent Successfully, Check Error Logs For Details")
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
+		log.Println("Video Not Sent Successfully, Check Error Logs For Details")
+		return Message{}, err
 	}
-	return nil
 
+	return message, nil
 }
 
-func (b *Bot) SendVideo(file string, caption string, c Chat, options MediaOptions) error {
-
-	link := b.APIURL + "/sendVideo"
-
-	if regexp.MustCompile("^(https?)").MatchString(file) || file[:len(file)-4] != ".mp4" {
-		body := videoBody{
-			ChatID:  strconv.Itoa(c.ID),
-			Video:   file,
-			Caption: caption,
-		}
+// SendVideo : Send A Video From A Local Path, URL Or File ID
+func (b *Bot) SendVideo(file string, caption string, c Chat, options MediaOptions) (Message, error) {
+	return b.SendVideoCtx(context.Background(), file, caption, c, options)
+}
 
-		if options.UseSpoiler {
-			body.HasSpoiler = true
-		}
+// SendVideoCtx : SendVideo With A Context
+func (b *Bot) SendVideoCtx(ctx context.Context, file string, caption string, c Chat, options MediaOptions) (Message, error) {
+	if err := checkCaption(caption); err != nil {
+		return Message{}, err
+	}
 
-		if options.ProtectContent {
-			body.ProtectContent = true
-		}
+	body := videoBody{
+		ChatID:         strconv.Itoa(c.ID),
+		Caption:        caption,
+		HasSpoiler:     options.UseSpoiler,
+		ProtectContent: options.ProtectContent,
+		ReplyMarkup:    b.markupFor(c.ID, options.ReplyMarkup),
+	}
 
-		jsonBody, err := json.Marshal(body)
+	if !isLocalFile(file) {
+		body.Video = file
 
-		if err != nil {
-			log.Println("There Was An Error Marshalling The Object")
-			return err
-		}
+		var message Message
 
-		resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+		err := b.Call(ctx, "sendVideo", body, &message)
 
 		if err != nil {
-			log.Println("Video Couldn't Be Sent Successfully, Please Check Internet Source")
-			return err
-		}
-
-		defer func() { _ = resp.Body.Close() }()
-
-		if resp.StatusCode != http.StatusOK {
 			log.Println("Video Not Sent Successfully, Check Error Logs For More Details")
-			body, _ := io.ReadAll(resp.Body)
-			return errors.New(string(body))
+			return Message{}, err
 		}
 
-		return nil
+		return message, nil
 	}
+
 	vid, err := os.Open(file)
 
 	if err != nil {
 		log.Println("Couldn't Open Specified File For Reading")
-		return err
+		return Message{}, err
 	}
 
 	defer func() { _ = vid.Close() }()
 
-	body := new(bytes.Buffer)
+	var message Message
 
-	writer := multipart.NewWriter(body)
-
-	part, err := writer.CreateFormFile("video", filepath.Base(file))
-
-	if err != nil {
-		return err
-	}
-
-	_, err = io.Copy(part, vid)
+	err = b.CallMultipart(ctx, "sendVideo", body, []InputFile{{Field: "video", Name: filepath.Base(file), Reader: vid}}, &message)
 
 	if err != nil {
-		return err
+		log.Println("Video Not Sent Successfully, Check Error Logs For Details")
+		return Message{}, err
 	}
 
-	_ = writer.WriteField("chat_id", strconv.Itoa(c.ID))
+	return message, nil
+}
 
-	if caption != "" {
-		_ = writer.WriteField("caption", caption)
-	}
+// SendPhotoFromMemory : Upload A Photo Held In Memory
+func (b *Bot) SendPhotoFromMemory(data []byte, caption string, c Chat, options MediaOptions) (Message, error) {
+	return b.SendPhotoFromMemoryCtx(context.Background(), data, caption, c, options)
+}
 
-	if options.UseSpoiler {
-		_ = writer.WriteField("has_spoiler", "true")
+// SendPhotoFromMemoryCtx : SendPhotoFromMemory With A Context
+func (b *Bot) SendPhotoFromMemoryCtx(ctx context.Context, data []byte, caption string, c Chat, options MediaOptions) (Message, error) {
+	if err := checkCaption(caption); err != nil {
+		return Message{}, err
 	}
 
-	if options.ProtectContent {
-		_ = writer.WriteField("protect_content", "true")
+	body := photoBody{
+		ChatID:         strconv.Itoa(c.ID),
+		Caption:        caption,
+		HasSpoiler:     options.UseSpoiler,
+		ProtectContent: options.ProtectContent,
+		ReplyMarkup:    b.markupFor(c.ID, options.ReplyMarkup),
 	}
 
-	_ = writer.Close()
+	var message Message
 
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", link, body)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	resp, err := client.Do(req)
+	err := b.CallMultipart(ctx, "sendPhoto", body, []InputFile{{Field: "photo", Name: "photo.jpg", Reader: bytes.NewReader(data)}}, &message)
 
 	if err != nil {
-		log.Println("Couldn't Send Video, Check Internet Connection")
-		return err
-	}
-
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		log.Println("Video Not Sent Successfully, Check Error Logs For Details")
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
+		log.Println("Photo Not Sent Successfully, Check Error Logs For Details")
+		return Message{}, err
 	}
-	return nil
 
+	return message, nil
 }
 
-func (b *Bot) SendPhotoFromMemory(data []byte, caption string, c Chat, options MediaOptions) error {
-	link := b.APIURL + "/sendPhoto"
-
-	buffer := bytes.NewReader(data)
-
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
+// SendPhoto : Send A Photo From A Local Path, URL Or File ID
+func (b *Bot) SendPhoto(file string, caption string, c Chat, options MediaOptions) (Message, error) {
+	return b.SendPhotoCtx(context.Background(), file, caption, c, options)
+}
 
-	part, err := writer.CreateFormFile("photo", "photo.jpg")
-	if err != nil {
-		return err
+// SendPhotoCtx : SendPhoto With A Context
+func (b *Bot) SendPhotoCtx(ctx context.Context, file string, caption string, c Chat, options MediaOptions) (Message, error) {
+	if err := checkCaption(caption); err != nil {
+		return Message{}, err
 	}
 
-	_, err = io.Copy(part, buffer)
-	if err != nil {
-		return err
+	body := photoBody{
+		ChatID:         strconv.Itoa(c.ID),
+		Caption:        caption,
+		HasSpoiler:     options.UseSpoiler,
+		ProtectContent: options.ProtectContent,
+		ReplyMarkup:    b.markupFor(c.ID, options.ReplyMarkup),
 	}
 
-	_ = writer.WriteField("chat_id", strconv.Itoa(c.ID))
+	if !isLocalFile(file) {
+		body.Photo = file
 
-	if caption != "" {
-		_ = writer.WriteField("caption", caption)
-	}
+		var message Message
 
-	if options.UseSpoiler {
-		_ = writer.WriteField("has_spoiler", "true")
-	}
+		err := b.Call(ctx, "sendPhoto", body, &message)
 
-	if options.ProtectContent {
-		_ = writer.WriteField("protect_content", "true")
-	}
+		if err != nil {
+			log.Println("Photo Not Sent Successfully, Check Error Logs For More Details")
+			return Message{}, err
+		}
 
-	_ = writer.Close()
+		return message, nil
+	}
 
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", link, body)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	resp, err := client.Do(req)
+	photo, err := os.Open(file)
 
 	if err != nil {
-		log.Println("Couldn't Send Photo, Check Internet Connection")
-		return err
+		log.Println("Couldn't Open Specified File For Reading")
+		return Message{}, err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
+	defer func() { _ = photo.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
+	var message Message
+
+	err = b.CallMultipart(ctx, "sendPhoto", body, []InputFile{{Field: "photo", Name: filepath.Base(file), Reader: photo}}, &message)
+
+	if err != nil {
 		log.Println("Photo Not Sent Successfully, Check Error Logs For Details")
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
+		return Message{}, err
 	}
-	return nil
 
+	return message, nil
 }
 
-func (b *Bot) SendPhoto(file string, caption string, c Chat, options MediaOptions) error {
-
-	link := b.APIURL + "/sendPhoto"
+// SendMediaGroup : Send A Group Of Media Files
+// Local Paths Are Uploaded, URLs And File IDs Are Passed Through As Is
+func (b *Bot) SendMediaGroup(files []InputMedia, c Chat, options MediaOptions) ([]Message, error) {
+	return b.SendMediaGroupCtx(context.Background(), files, c, options)
+}
 
-	if regexp.MustCompile("^(https?)").MatchString(file) || file[:len(file)-4] != ".jpg" && file[:len(file)-4] != ".png" && file[:len(file)-4] != ".jpeg" {
-		body := videoBody{
-			ChatID:  strconv.Itoa(c.ID),
-			Video:   file,
-			Caption: caption,
+// SendMediaGroupCtx : SendMediaGroup With A Context
+func (b *Bot) SendMediaGroupCtx(ctx context.Context, files []InputMedia, c Chat, options MediaOptions) ([]Message, error) {
+	for _, file := range files {
+		if err := checkCaption(file.Caption); err != nil {
+			return nil, err
 		}
+	}
 
-		if options.UseSpoiler {
-			body.HasSpoiler = true
-		}
+	media := make([]InputMedia, len(files))
+	copy(media, files)
 
-		if options.ProtectContent {
-			body.ProtectContent = true
-		}
+	uploads := make([]InputFile, 0, len(files))
+	opened := make([]*os.File, 0, len(files))
 
-		jsonBody, err := json.Marshal(body)
+	defer func() {
+		for _, file := range opened {
+			_ = file.Close()
+		}
+	}()
 
-		if err != nil {
-			log.Println("There Was An Error Marshalling The Object")
-			return err
+	for i := range media {
+		if !isLocalFile(media[i].Media) {
+			continue
 		}
 
-		resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
+		file, err := os.Open(media[i].Media)
 
 		if err != nil {
-			log.Println("Photo Couldn't Be Sent Successfully, Please Check Internet Source")
-			return err
+			log.Println("Couldn't Open Specified File For Reading")
+			return nil, err
 		}
 
-		defer func() { _ = resp.Body.Close() }()
-
-		if resp.StatusCode != http.StatusOK {
-			log.Println("Photo Not Sent Successfully, Check Error Logs For More Details")
-			body, _ := io.ReadAll(resp.Body)
-			return errors.New(string(body))
-		}
+		opened = append(opened, file)
 
-		return nil
-	}
+		name := fmt.Sprintf("file%d", i)
 
-	photo, err := os.Open(file)
+		uploads = append(uploads, InputFile{Field: name, Name: filepath.Base(media[i].Media), Reader: file})
 
-	if err != nil {
-		log.Println("Couldn't Open Specified File For Reading")
-		return err
+		media[i].Media = "attach://" + name
 	}
 
-	defer func() { _ = photo.Close() }()
-
-	body := new(bytes.Buffer)
+	group := mediaGroup{
+		ChatID:              strconv.Itoa(c.ID),
+		Media:               media,
+		DisableNotification: !options.SendNotification,
+		ProtectContent:      options.ProtectContent,
+	}
 
-	writer := multipart.NewWriter(body)
+	var messages []Message
 
-	part, err := writer.CreateFormFile("photo", filepath.Base(file))
+	err := b.CallMultipart(ctx, "sendMediaGroup", group, uploads, &messages)
 
 	if err != nil {
-		return err
+		log.Println("Media Group Not Sent Successfully, Check Error Logs For More Details")
+		return nil, err
 	}
 
-	_, err = io.Copy(part, photo)
+	return messages, nil
+}
 
-	if err != nil {
-		return err
+// isLocalFile : Report Whether file Should Be Uploaded Rather Than Sent As A URL Or File ID
+func isLocalFile(file string) bool {
+	if regexp.MustCompile("^(https?)://").MatchString(file) {
+		return false
 	}
 
-	_ = writer.WriteField("chat_id", strconv.Itoa(c.ID))
+	info, err := os.Stat(file)
 
-	if caption != "" {
-		_ = writer.WriteField("caption", caption)
-	}
+	return err == nil && !info.IsDir()
+}
 
-	if options.UseSpoiler {
-		_ = writer.WriteField("has_spoiler", "true")
-	}
+// openFile : Open A Reader For The Contents Of The File With The Given ID
+// With BotOptions.LocalMode The Server Returns An Absolute Path, Which Is Read Directly From Disk
+// Otherwise Every Path Is Downloaded From The Server, So It Can't Point The Bot At Local Files
+func (b *Bot) openFile(ctx context.Context, fileId string) (io.ReadCloser, error) {
+	fileDetails, err := b.fetchFileDetails(ctx, fileId)
 
-	if options.ProtectContent {
-		_ = writer.WriteField("protect_content", "true")
+	if err != nil {
+		return nil, err
 	}
 
-	_ = writer.Close()
+	if b.localMode && filepath.IsAbs(fileDetails.FilePath) {
+		return os.Open(fileDetails.FilePath)
+	}
 
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", link, body)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	resp, err := client.Do(req)
+	resp, err := b.get(ctx, b.fileURL(fileDetails.FilePath))
 
 	if err != nil {
-		log.Println("Couldn't Send Photo, Check Internet Connection")
-		return err
+		return nil, err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		log.Println("Photo Not Sent Successfully, Check Error Logs For Details")
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
-	}
-	return nil
+	return resp.Body, nil
 }
 
-// SendMediaGroup : Send A Group Of Media Files
-// It Only Works With Local Files For Now
-func (b *Bot) SendMediaGroup(files []InputMedia, c Chat, options MediaOptions) error {
-
-	link := b.APIURL + "/sendMediaGroup"
-
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-
-	for _, file := range files {
-		if m, err := regexp.MatchString(`^(https?)`, file.Media); err != nil || !m {
-			if err != nil {
-				log.Println("There Was An Error Checking The Media Link")
-				continue
-			}
-
-			photo, err := os.Open(file.Media)
+func (b *Bot) fetchFileDetails(ctx context.Context, fileId string) (*fileDets, error) {
+	var file fileDets
 
-			if err != nil {
-				log.Println("Couldn't Open Specified File For Reading")
-				continue
-			}
-
-			file.Media = "attach://" + filepath.Base(file.Media)
-			part, err := writer.CreateFormFile(filepath.Base(file.Media), file.Media)
-			if err != nil {
-				log.Println("There Was An Error Creating The Form File")
-				continue
-			}
-
-			_, err = io.Copy(part, photo)
-
-			if err != nil {
-				log.Println("There Was An Error Copying The File")
-				continue
-			}
-
-			_ = photo.Close()
-		}
-	}
-
-	jsonBody, err := json.Marshal(files)
+	err := b.Call(ctx, "getFile", struct {
+		FileID string `json:"file_id"`
+	}{
+		fileId,
+	}, &file)
 
 	if err != nil {
-		log.Println("There Was An Error Marshalling The Object")
-		return err
+		return nil, err
 	}
 
-	_ = writer.WriteField("chat_id", strconv.Itoa(c.ID))
-	_ = writer.WriteField("disable_notification", strconv.FormatBool(!options.SendNotification))
-	_ = writer.WriteField("disable_content_type_detection", strconv.FormatBool(options.ProtectContent))
-	_ = writer.WriteField("media", string(jsonBody))
+	return &file, nil
+}
 
-	/*mg := mediaGroup{
-		ChatID:              strconv.Itoa(c.ID),
-		Media:               files,
-		DisableNotification: !options.SendNotification,
-		ProtectContent:      options.ProtectContent,
-	}*/
+// LogOut : Log The Bot Out Of The Cloud Bot API Server Before Moving It To A Self-Hosted One
+func (b *Bot) LogOut() error {
+	return b.LogOutCtx(context.Background())
+}
 
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", link, body)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	resp, err := client.Do(req)
+// LogOutCtx : LogOut With A Context
+func (b *Bot) LogOutCtx(ctx context.Context) error {
+	err := b.Call(ctx, "logOut", nil, nil)
 
 	if err != nil {
-		log.Println("Media Group Couldn't Be Sent Successfully, Please Check Internet Source")
+		log.Println("Couldn't Log Out From The Bot API Server")
 		return err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		log.Println("Media Group Not Sent Successfully, Check Error Logs For More Details")
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
-	}
-
 	return nil
 }
 
-func (b *Bot) fetchFileDetails(fileId string) (*result, error) {
-	var res result
-
-	link := b.APIURL + "/getFile"
-	jsonBody, err := json.Marshal(struct {
-		FileID string `json:"file_id"`
-	}{
-		fileId,
-	})
-
-	resp, err := http.Post(link, "application/json", bytes.NewBuffer(jsonBody))
-
-	if err != nil {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, errors.New(string(body))
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, errors.New(string(body))
-	}
-
-	defer func() { _ = resp.Body.Close() }()
+// Close : Close The Bot Instance Before Moving It From One Self-Hosted Server To Another
+func (b *Bot) Close() error {
+	return b.CloseCtx(context.Background())
+}
 
-	err = json.NewDecoder(resp.Body).Decode(&res)
+// CloseCtx : Close With A Context
+func (b *Bot) CloseCtx(ctx context.Context) error {
+	err := b.Call(ctx, "close", nil, nil)
 
 	if err != nil {
-		return nil, err
+		log.Println("Couldn't Close The Bot Instance")
+		return err
 	}
 
-	return &res, nil
-
+	return nil
 }
@@ -0,0 +1,281 @@
+package goTelegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeAPI : Stands In For The Bot API, Answering Each Method With The Handler Registered For It
+// Methods Without A Handler Succeed With A true Result
+type fakeAPI struct {
+	server  *httptest.Server
+	mu      sync.Mutex
+	methods map[string]func(body []byte) (int, string)
+	calls   map[string][][]byte
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	t.Helper()
+
+	api := &fakeAPI{
+		methods: make(map[string]func(body []byte) (int, string)),
+		calls:   make(map[string][][]byte),
+	}
+
+	api.handle("getMe", func([]byte) (int, string) {
+		return http.StatusOK, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"Test","username":"testbot"}}`
+	})
+
+	api.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		body, _ := io.ReadAll(r.Body)
+
+		api.mu.Lock()
+		api.calls[method] = append(api.calls[method], body)
+		fn := api.methods[method]
+		api.mu.Unlock()
+
+		status, response := http.StatusOK, `{"ok":true,"result":true}`
+
+		if fn != nil {
+			status, response = fn(body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+
+	t.Cleanup(api.server.Close)
+
+	return api
+}
+
+func (api *fakeAPI) handle(method string, fn func(body []byte) (int, string)) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.methods[method] = fn
+}
+
+// requests : Bodies Of Every Call Made To method So Far
+func (api *fakeAPI) requests(method string) [][]byte {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return append([][]byte(nil), api.calls[method]...)
+}
+
+// newTestBot : A Bot Talking To api, With options Applied On Top Of The Endpoint
+func newTestBot(t *testing.T, api *fakeAPI, options BotOptions) *Bot {
+	t.Helper()
+
+	options.APIEndpoint = api.server.URL
+
+	bot, err := NewBotWithOptions(context.Background(), "123:abc", options)
+
+	if err != nil {
+		t.Fatalf("NewBotWithOptions: %v", err)
+	}
+
+	return &bot
+}
+
+func TestEditMessageWithOptionsMarkup(t *testing.T) {
+	inline := InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboard{{CallbackButton("ok", "ok")}}}
+
+	tests := []struct {
+		name       string
+		chat       func(b *Bot)
+		markup     ReplyMarkup
+		wantMarkup bool
+		wantErr    bool
+	}{
+		{name: "explicit inline keyboard", markup: inline, wantMarkup: true},
+		{name: "explicit inline keyboard pointer", markup: &inline, wantMarkup: true},
+		{name: "chat inline keyboard", chat: func(b *Bot) { b.SetReplyMarkup(5, inline) }, wantMarkup: true},
+		{name: "chat reply keyboard is left out", chat: func(b *Bot) { b.SetReplyMarkup(5, ReplyKeyboardMarkup{}) }},
+		{name: "explicit reply keyboard", markup: ReplyKeyboardMarkup{}, wantErr: true},
+		{name: "explicit force reply", markup: ForceReply{}, wantErr: true},
+		{name: "explicit keyboard removal", markup: ReplyKeyboardRemove{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+
+			api.handle("editMessageText", func([]byte) (int, string) {
+				return http.StatusOK, `{"ok":true,"result":{"message_id":2,"chat":{"id":5}}}`
+			})
+
+			bot := newTestBot(t, api, BotOptions{})
+
+			if tt.chat != nil {
+				tt.chat(bot)
+			}
+
+			_, err := bot.EditMessageWithOptions(Message{MessageID: 2, Chat: Chat{ID: 5}}, "edited", MessageOptions{ReplyMarkup: tt.markup})
+			sent := api.requests("editMessageText")
+
+			if tt.wantErr {
+				if err == nil || len(sent) != 0 {
+					t.Fatalf("got err %v after %d requests, want an error before anything is sent", err, len(sent))
+				}
+
+				return
+			}
+
+			if err != nil || len(sent) != 1 {
+				t.Fatalf("got err %v after %d requests", err, len(sent))
+			}
+
+			var req struct {
+				ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup"`
+			}
+
+			if err := json.Unmarshal(sent[0], &req); err != nil {
+				t.Fatal(err)
+			}
+
+			if (req.ReplyMarkup != nil) != tt.wantMarkup {
+				t.Errorf("reply_markup sent = %v, want %v", req.ReplyMarkup != nil, tt.wantMarkup)
+			}
+		})
+	}
+}
+
+func TestNewBotErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		response    string
+		wantInvalid bool
+		wantLimited bool
+		wantCode    int
+	}{
+		{name: "unauthorized", status: http.StatusUnauthorized, response: `{"ok":false,"error_code":401,"description":"Unauthorized"}`, wantInvalid: true, wantCode: 401},
+		{name: "not found", status: http.StatusNotFound, response: `{"ok":false,"error_code":404,"description":"Not Found"}`, wantInvalid: true, wantCode: 404},
+		{name: "rate limited", status: http.StatusTooManyRequests, response: `{"ok":false,"error_code":429,"description":"Too Many Requests","parameters":{"retry_after":5}}`, wantLimited: true, wantCode: 429},
+		{name: "server error", status: http.StatusBadGateway, response: `{"ok":false,"error_code":502,"description":"Bad Gateway"}`, wantCode: 502},
+		{name: "ok false with status 200", status: http.StatusOK, response: `{"ok":false,"error_code":400,"description":"Bad Request: nope"}`, wantCode: 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+
+			api.handle("getMe", func([]byte) (int, string) { return tt.status, tt.response })
+
+			_, err := NewBotWithOptions(context.Background(), "123:abc", BotOptions{
+				APIEndpoint: api.server.URL,
+				RateLimit:   RateLimitOptions{MaxRetries: -1},
+			})
+
+			if errors.Is(err, ErrInvalidToken) != tt.wantInvalid {
+				t.Errorf("errors.Is(%v, ErrInvalidToken) = %v, want %v", err, !tt.wantInvalid, tt.wantInvalid)
+			}
+
+			if IsRateLimited(err) != tt.wantLimited {
+				t.Errorf("IsRateLimited(%v) = %v, want %v", err, !tt.wantLimited, tt.wantLimited)
+			}
+
+			var apiErr *APIError
+
+			if !errors.As(err, &apiErr) || apiErr.ErrorCode != tt.wantCode {
+				t.Fatalf("error %v doesn't carry an *APIError with code %d", err, tt.wantCode)
+			}
+
+			if tt.wantLimited && apiErr.Parameters.RetryAfter != 5 {
+				t.Errorf("RetryAfter = %d, want 5", apiErr.Parameters.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestCallReturnsAPIErrorForOkFalse(t *testing.T) {
+	api := newFakeAPI(t)
+
+	api.handle("sendMessage", func([]byte) (int, string) {
+		return http.StatusOK, `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`
+	})
+
+	bot := newTestBot(t, api, BotOptions{})
+
+	if _, err := bot.SendMessage("hi", Chat{ID: 5}); !IsChatNotFound(err) {
+		t.Errorf("IsChatNotFound(%v) = false, want true", err)
+	}
+}
+
+func TestFileURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		local    bool
+		path     string
+		want     string
+	}{
+		{name: "relative path", endpoint: "https://api.telegram.org", path: "photos/a.jpg", want: "https://api.telegram.org/file/bot123:abc/photos/a.jpg"},
+		{name: "absolute path is fetched from the server", endpoint: "https://api.telegram.org", path: "/etc/passwd", want: "https://api.telegram.org/file/bot123:abc/etc/passwd"},
+		{name: "custom endpoint", endpoint: "http://localhost:8081/", path: "docs/b.pdf", want: "http://localhost:8081/file/bot123:abc/docs/b.pdf"},
+		{name: "local mode relative path", endpoint: "http://localhost:8081", local: true, path: "docs/b.pdf", want: "http://localhost:8081/file/bot123:abc/docs/b.pdf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := &Bot{baseURL: strings.TrimSuffix(tt.endpoint, "/"), token: "123:abc", localMode: tt.local}
+
+			if got := bot.fileURL(tt.path); got != tt.want {
+				t.Errorf("fileURL(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadFileToMemory(t *testing.T) {
+	local := filepath.Join(t.TempDir(), "local.txt")
+
+	if err := os.WriteFile(local, []byte("from disk"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		local bool
+		path  string
+		want  string
+	}{
+		{name: "remote relative path", path: "docs/remote.txt", want: "from server"},
+		{name: "remote ignores absolute paths on disk", path: local, want: "from server"},
+		{name: "local mode reads absolute paths", local: true, path: local, want: "from disk"},
+		{name: "local mode downloads relative paths", local: true, path: "docs/remote.txt", want: "from server"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			path, _ := json.Marshal(tt.path)
+
+			api.handle("getFile", func([]byte) (int, string) {
+				return http.StatusOK, `{"ok":true,"result":{"file_id":"f","file_path":` + string(path) + `}}`
+			})
+			api.handle(filepath.Base(tt.path), func([]byte) (int, string) { return http.StatusOK, "from server" })
+
+			bot := newTestBot(t, api, BotOptions{LocalMode: tt.local})
+
+			data, err := bot.DownloadFileToMemory("f")
+
+			if err != nil || string(data) != tt.want {
+				t.Errorf("DownloadFileToMemory = %q, %v, want %q", data, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package goTelegram
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Limits Telegram Places On Inline Keyboards
+const (
+	MaxInlineButtons      = 100
+	MaxButtonsPerRow      = 8
+	MaxCallbackDataLength = 64
+)
+
+// InlineKeyboard : A Button On An Inline Keyboard
+// Besides Text, Exactly One Of The Other Fields Must Be Set, Which The Constructors Below Take Care Of
+type InlineKeyboard struct {
+	Text                         string                       `json:"text"`
+	URL                          string                       `json:"url,omitempty"`
+	Data                         string                       `json:"callback_data,omitempty"`
+	WebApp                       *WebAppInfo                  `json:"web_app,omitempty"`
+	LoginURL                     *LoginURL                    `json:"login_url,omitempty"`
+	SwitchInlineQuery            *string                      `json:"switch_inline_query,omitempty"`
+	SwitchInlineQueryCurrentChat *string                      `json:"switch_inline_query_current_chat,omitempty"`
+	SwitchInlineQueryChosenChat  *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
+	CopyText                     *CopyTextButton              `json:"copy_text,omitempty"`
+	CallbackGame                 *CallbackGame                `json:"callback_game,omitempty"`
+	Pay                          bool                         `json:"pay,omitempty"`
+}
+
+// LoginURL : Logs The User Into A Website Through Telegram When The Button Is Pressed
+type LoginURL struct {
+	URL                string `json:"url"`
+	ForwardText        string `json:"forward_text,omitempty"`
+	BotUsername        string `json:"bot_username,omitempty"`
+	RequestWriteAccess bool   `json:"request_write_access,omitempty"`
+}
+
+// SwitchInlineQueryChosenChat : Lets The User Pick A Chat To Start An Inline Query In
+type SwitchInlineQueryChosenChat struct {
+	Query             string `json:"query,omitempty"`
+	AllowUserChats    bool   `json:"allow_user_chats,omitempty"`
+	AllowBotChats     bool   `json:"allow_bot_chats,omitempty"`
+	AllowGroupChats   bool   `json:"allow_group_chats,omitempty"`
+	AllowChannelChats bool   `json:"allow_channel_chats,omitempty"`
+}
+
+// CopyTextButton : Text Copied To The Clipboard When The Button Is Pressed
+type CopyTextButton struct {
+	Text string `json:"text"`
+}
+
+// CallbackGame : Placeholder For A Button That Launches The Game Sent With The Message
+type CallbackGame struct{}
+
+// CallbackButton : A Button That Sends data Back To The Bot As A CallbackQuery
+func CallbackButton(text, data string) InlineKeyboard {
+	return InlineKeyboard{Text: text, Data: data}
+}
+
+// URLButton : A Button That Opens url
+func URLButton(text, url string) InlineKeyboard {
+	return InlineKeyboard{Text: text, URL: url}
+}
+
+// WebAppButton : A Button That Opens The Web App At url
+func WebAppButton(text, url string) InlineKeyboard {
+	return InlineKeyboard{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// LoginButton : A Button That Logs The User Into A Website
+func LoginButton(text string, login LoginURL) InlineKeyboard {
+	return InlineKeyboard{Text: text, LoginURL: &login}
+}
+
+// SwitchInlineQueryButton : A Button That Lets The User Pick A Chat And Starts An Inline Query With query There
+func SwitchInlineQueryButton(text, query string) InlineKeyboard {
+	return InlineKeyboard{Text: text, SwitchInlineQuery: &query}
+}
+
+// SwitchInlineQueryCurrentChatButton : A Button That Starts An Inline Query With query In The Current Chat
+func SwitchInlineQueryCurrentChatButton(text, query string) InlineKeyboard {
+	return InlineKeyboard{Text: text, SwitchInlineQueryCurrentChat: &query}
+}
+
+// SwitchInlineQueryChosenChatButton : A Button That Starts An Inline Query In A Chat Of The Allowed Types
+func SwitchInlineQueryChosenChatButton(text string, chosen SwitchInlineQueryChosenChat) InlineKeyboard {
+	return InlineKeyboard{Text: text, SwitchInlineQueryChosenChat: &chosen}
+}
+
+// CopyButton : A Button That Copies copyText To The Clipboard
+func CopyButton(text, copyText string) InlineKeyboard {
+	return InlineKeyboard{Text: text, CopyText: &CopyTextButton{Text: copyText}}
+}
+
+// GameButton : A Button That Launches A Game, It Must Be The First Button On The First Row
+func GameButton(text string) InlineKeyboard {
+	return InlineKeyboard{Text: text, CallbackGame: &CallbackGame{}}
+}
+
+// PayButton : A Button That Pays An Invoice, It Must Be The First Button On The First Row
+func PayButton(text string) InlineKeyboard {
+	return InlineKeyboard{Text: text, Pay: true}
+}
+
+// Validate : Check The Button Has Text, Exactly One Action And No More Than MaxCallbackDataLength Bytes Of Callback Data
+func (k InlineKeyboard) Validate() error {
+	if k.Text == "" {
+		return errors.New("inline Button Has No Text")
+	}
+
+	set := 0
+
+	for _, isSet := range []bool{
+		k.URL != "",
+		k.Data != "",
+		k.WebApp != nil,
+		k.LoginURL != nil,
+		k.SwitchInlineQuery != nil,
+		k.SwitchInlineQueryCurrentChat != nil,
+		k.SwitchInlineQueryChosenChat != nil,
+		k.CopyText != nil,
+		k.CallbackGame != nil,
+		k.Pay,
+	} {
+		if isSet {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return fmt.Errorf("inline Button %q Must Have Exactly One Action, It Has %d", k.Text, set)
+	}
+
+	if len(k.Data) > MaxCallbackDataLength {
+		return fmt.Errorf("callback Data For Button %q Is %d Bytes, The Limit Is %d", k.Text, len(k.Data), MaxCallbackDataLength)
+	}
+
+	return nil
+}
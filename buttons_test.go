@@ -0,0 +1,67 @@
+package goTelegram
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInlineKeyboardValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		button  InlineKeyboard
+		wantErr bool
+	}{
+		{name: "callback", button: CallbackButton("ok", "ok")},
+		{name: "data at the limit", button: CallbackButton("ok", strings.Repeat("d", MaxCallbackDataLength))},
+		{name: "url", button: URLButton("site", "https://example.com")},
+		{name: "pay", button: PayButton("pay")},
+		{name: "no text", button: InlineKeyboard{Data: "ok"}, wantErr: true},
+		{name: "empty data", button: InlineKeyboard{Text: "ok"}, wantErr: true},
+		{name: "data over the limit", button: CallbackButton("ok", strings.Repeat("d", MaxCallbackDataLength+1)), wantErr: true},
+		{name: "more than one action", button: InlineKeyboard{Text: "ok", Data: "ok", URL: "https://example.com"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.button.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddButtons(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []string
+		wantErr   bool
+		wantCount int
+	}{
+		{name: "valid", data: []string{"a", "1", "b", "2"}, wantCount: 2},
+		{name: "odd number of arguments", data: []string{"a", "1", "b"}, wantErr: true},
+		{name: "empty data", data: []string{"a", "1", "x", ""}, wantErr: true},
+		{name: "no text", data: []string{"", "1"}, wantErr: true},
+		{name: "data over the limit", data: []string{"a", strings.Repeat("d", MaxCallbackDataLength+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+			bot.CreateKeyboard(1)
+
+			if err := bot.AddButtons(1, tt.data...); (err != nil) != tt.wantErr {
+				t.Fatalf("AddButtons = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			count := 0
+
+			for _, row := range bot.keyboardManager.ReturnKeyboard(1) {
+				count += len(row)
+			}
+
+			if count != tt.wantCount {
+				t.Errorf("keyboard has %d buttons, want %d", count, tt.wantCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package goTelegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"mime/multipart"
+	"sort"
+)
+
+// InputFile : A File Uploaded As Part Of A Multipart Request
+type InputFile struct {
+	// Field : Form Field The File Is Sent Under, e.g "photo" Or The Name Used In attach://<name>
+	Field string
+	// Name : File Name Reported To Telegram
+	Name   string
+	Reader io.Reader
+}
+
+type apiResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      json.RawMessage    `json:"result"`
+	ErrorCode   int                `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// Call : Invoke Any Bot API Method
+// params Is Encoded As JSON (Pass nil For Methods Without Parameters) And The "result" Field
+// Of The Response Is Decoded Into result, Which May Be nil When The Result Isn't Needed
+func (b *Bot) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	var resp []byte
+	var err error
+
+	if params == nil {
+		resp, err = b.do(ctx, method, "", nil)
+	} else {
+		resp, err = b.doJSON(ctx, method, params)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return decodeResult(resp, result)
+}
+
+// CallMultipart : Invoke Any Bot API Method That Takes File Uploads
+// Every Top-Level Field Of params Becomes A Form Field, Objects And Arrays Are Sent As JSON Strings
+func (b *Bot) CallMultipart(ctx context.Context, method string, params interface{}, files []InputFile, result interface{}) error {
+	contentType, body, err := encodeMultipart(params, files)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.do(ctx, method, contentType, body)
+
+	if err != nil {
+		return err
+	}
+
+	return decodeResult(resp, result)
+}
+
+func decodeResult(resp []byte, result interface{}) error {
+	var response apiResponse
+
+	err := json.Unmarshal(resp, &response)
+
+	if err != nil {
+		log.Println("Couldn't Unmarshal Response")
+		return err
+	}
+
+	if !response.Ok {
+		return &APIError{ErrorCode: response.ErrorCode, Description: response.Description, Parameters: response.Parameters}
+	}
+
+	if result == nil || len(response.Result) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+func encodeMultipart(params interface{}, files []InputFile) (string, []byte, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	fields := make(map[string]json.RawMessage)
+
+	if params != nil {
+		jsonBody, err := json.Marshal(params)
+
+		if err != nil {
+			log.Println("There Was An Error Marshalling The Object")
+			return "", nil, err
+		}
+
+		err = json.Unmarshal(jsonBody, &fields)
+
+		if err != nil {
+			return "", nil, errors.New("multipart Parameters Must Encode To A JSON Object")
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw := fields[name]
+
+		if string(raw) == "null" {
+			continue
+		}
+
+		value := string(raw)
+
+		var s string
+
+		if json.Unmarshal(raw, &s) == nil {
+			value = s
+		}
+
+		_ = writer.WriteField(name, value)
+	}
+
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.Field, file.Name)
+
+		if err != nil {
+			log.Println("There Was An Error Creating The Form File")
+			return "", nil, err
+		}
+
+		_, err = io.Copy(part, file.Reader)
+
+		if err != nil {
+			log.Println("There Was An Error Copying The File")
+			return "", nil, err
+		}
+	}
+
+	err := writer.Close()
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), body.Bytes(), nil
+}
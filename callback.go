@@ -0,0 +1,354 @@
+package goTelegram
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrCallbackNotFound : Returned When A Stored Callback Payload Is Missing Or Has Expired
+var ErrCallbackNotFound = errors.New("callback Payload Not Found Or Expired")
+
+// ErrCallbackPayloadsDisabled : Returned By CallbackData Before UseCallbackPayloads Is Called
+var ErrCallbackPayloadsDisabled = errors.New("callback Payloads Are Disabled, Call UseCallbackPayloads First")
+
+const (
+	// callbackInline : Marks callback_data Carrying Its Payload Directly
+	callbackInline = "~j:"
+	// callbackStored : Marks callback_data Carrying The Key Of A Payload Held In The CallbackStore
+	callbackStored = "~k:"
+	// callbackKeyLength : Bytes Of The Payload's Hash Used As Its Key In The CallbackStore
+	callbackKeyLength = 16
+)
+
+// CallbackStore : Holds Callback Payloads Too Large To Fit In Telegram's 64 Byte callback_data
+// Implementations Must Be Safe For Concurrent Use
+type CallbackStore interface {
+	// Save : Keep data Under key, Overwriting Anything Already There
+	Save(ctx context.Context, key string, data []byte) error
+	// Load : The Data Saved Under key, Or ErrCallbackNotFound If There Is None
+	Load(ctx context.Context, key string) ([]byte, error)
+}
+
+// UseCallbackPayloads : Turn On Structured Callback Payloads, See CallbackData
+// store Holds Payloads That Don't Fit In callback_data, With nil Only Payloads That Fit Can Be Used
+func (b *Bot) UseCallbackPayloads(store CallbackStore) {
+	b.callbacks = &callbackCodec{store: store}
+}
+
+// CallbackData : Encode payload, Which Must Marshal To JSON, As callback_data Starting With prefix
+// Payloads That Fit Are Sent Inline, Others Are Saved In The CallbackStore Behind A Short Key
+// When The Button Is Pressed The Payload Is Resolved Before Handlers Run, Read It With CallbackQuery.DecodePayload
+// prefix Lets HandleCallback Route The Query As Usual, It Can't Contain "~", Which Starts The Encoded Payload
+func (b *Bot) CallbackData(prefix string, payload interface{}) (string, error) {
+	return b.CallbackDataCtx(context.Background(), prefix, payload)
+}
+
+// CallbackDataCtx : CallbackData With A Context
+func (b *Bot) CallbackDataCtx(ctx context.Context, prefix string, payload interface{}) (string, error) {
+	if b.callbacks == nil {
+		return "", ErrCallbackPayloadsDisabled
+	}
+
+	return b.callbacks.encode(ctx, prefix, payload)
+}
+
+type callbackCodec struct {
+	store CallbackStore
+}
+
+func (c *callbackCodec) encode(ctx context.Context, prefix string, payload interface{}) (string, error) {
+	if strings.Contains(prefix, "~") {
+		return "", fmt.Errorf("callback Prefix %q Can't Contain \"~\"", prefix)
+	}
+
+	raw, err := json.Marshal(payload)
+
+	if err != nil {
+		return "", err
+	}
+
+	if data := prefix + callbackInline + string(raw); len(data) <= MaxCallbackDataLength {
+		return data, nil
+	}
+
+	sum := sha256.Sum256(raw)
+	key := base64.RawURLEncoding.EncodeToString(sum[:callbackKeyLength])
+	data := prefix + callbackStored + key
+
+	if len(data) > MaxCallbackDataLength {
+		return "", fmt.Errorf("callback Prefix %q Is Too Long To Fit A Stored Payload Key", prefix)
+	}
+
+	if c.store == nil {
+		return "", fmt.Errorf("callback Payload Is %d Bytes And No CallbackStore Was Given To Hold It", len(raw))
+	}
+
+	if err := c.store.Save(ctx, key, raw); err != nil {
+		return "", err
+	}
+
+	return data, nil
+}
+
+// resolve : The Payload Carried By data, If It Was Encoded By CallbackData
+// The Marker Must Come Right After The Prefix, At The First "~", And What Follows It Must Be JSON
+// Or A Stored Key, Anything Else Is Treated As Ordinary callback_data
+func (c *callbackCodec) resolve(ctx context.Context, data string) (json.RawMessage, bool, error) {
+	at := strings.IndexByte(data, '~')
+
+	if at == -1 {
+		return nil, false, nil
+	}
+
+	marker := data[at:]
+
+	switch {
+	case strings.HasPrefix(marker, callbackInline):
+		rest := marker[len(callbackInline):]
+
+		if !json.Valid([]byte(rest)) {
+			return nil, false, nil
+		}
+
+		return json.RawMessage(rest), true, nil
+	case strings.HasPrefix(marker, callbackStored):
+		rest := marker[len(callbackStored):]
+
+		if key, err := base64.RawURLEncoding.DecodeString(rest); err != nil || len(key) != callbackKeyLength {
+			return nil, false, nil
+		}
+
+		if c.store == nil {
+			return nil, true, ErrCallbackNotFound
+		}
+
+		raw, err := c.store.Load(ctx, rest)
+
+		return raw, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
+// resolveCallback : Attach The Payload Of A Callback Query Encoded By CallbackData
+func (b *Bot) resolveCallback(ctx context.Context, update *Update) {
+	if b.callbacks == nil || update.CallbackQuery.Data == "" {
+		return
+	}
+
+	raw, encoded, err := b.callbacks.resolve(ctx, update.CallbackQuery.Data)
+
+	if !encoded {
+		return
+	}
+
+	update.CallbackQuery.Payload, update.CallbackQuery.payloadErr = raw, err
+}
+
+// DecodePayload : Unmarshal The Payload Encoded With CallbackData Into v
+func (q CallbackQuery) DecodePayload(v interface{}) error {
+	if q.payloadErr != nil {
+		return q.payloadErr
+	}
+
+	if q.Payload == nil {
+		return errors.New("callback Query Has No Payload")
+	}
+
+	return json.Unmarshal(q.Payload, v)
+}
+
+// MemoryCallbackStore : A CallbackStore Held In Memory, Forgetting Payloads ttl After They Were Last Saved
+// Payloads Are Lost When The Process Exits
+type MemoryCallbackStore struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]memoryCallback
+	saves   int
+}
+
+type memoryCallback struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryCallbackStore : Create A MemoryCallbackStore, A ttl Of 0 Keeps Payloads Forever
+func NewMemoryCallbackStore(ttl time.Duration) *MemoryCallbackStore {
+	return &MemoryCallbackStore{ttl: ttl, entries: make(map[string]memoryCallback)}
+}
+
+// Save : Keep data Under key Until The Store's ttl Passes
+func (s *MemoryCallbackStore) Save(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	entry := memoryCallback{data: append([]byte(nil), data...)}
+
+	if s.ttl > 0 {
+		entry.expires = now.Add(s.ttl)
+	}
+
+	s.entries[key] = entry
+	s.saves++
+
+	if s.saves%1024 == 0 {
+		for k, e := range s.entries {
+			if !e.expires.IsZero() && now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Load : The Data Saved Under key, Or ErrCallbackNotFound If There Is None Or It Expired
+func (s *MemoryCallbackStore) Load(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+
+	if !ok {
+		return nil, ErrCallbackNotFound
+	}
+
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		delete(s.entries, key)
+		return nil, ErrCallbackNotFound
+	}
+
+	return append([]byte(nil), entry.data...), nil
+}
+
+// FileCallbackStore : A CallbackStore Keeping Each Payload In Its Own File, So Payloads Survive Restarts
+// Payloads Are Forgotten ttl After They Were Last Saved, Expired Files Are Removed When The Store Is
+// Created And Every 1024 Saves After That
+type FileCallbackStore struct {
+	dir   string
+	ttl   time.Duration
+	saves atomic.Int64
+}
+
+// NewFileCallbackStore : Create A FileCallbackStore In dir, Creating It If Needed. A ttl Of 0 Keeps Payloads Forever
+func NewFileCallbackStore(dir string, ttl time.Duration) (*FileCallbackStore, error) {
+	err := os.MkdirAll(dir, 0o700)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileCallbackStore{dir: dir, ttl: ttl}
+	s.sweep()
+
+	return s, nil
+}
+
+// sweep : Remove Payloads, And Temporary Files Left By Interrupted Saves, Older Than ttl
+func (s *FileCallbackStore) sweep() {
+	if s.ttl <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(s.dir)
+
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if !entry.Type().IsRegular() || !(strings.HasSuffix(name, ".json") || strings.HasPrefix(name, "save-")) {
+			continue
+		}
+
+		info, err := entry.Info()
+
+		if err == nil && time.Since(info.ModTime()) > s.ttl {
+			_ = os.Remove(filepath.Join(s.dir, name))
+		}
+	}
+}
+
+func (s *FileCallbackStore) path(key string) (string, error) {
+	if key == "" || strings.ContainsAny(key, `/\.`) {
+		return "", ErrCallbackNotFound
+	}
+
+	return filepath.Join(s.dir, key+".json"), nil
+}
+
+// Save : Write data To The File For key, Replacing It Atomically
+func (s *FileCallbackStore) Save(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "save-*")
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = tmp.Write(data)
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	if s.saves.Add(1)%1024 == 0 {
+		s.sweep()
+	}
+
+	return nil
+}
+
+// Load : Read The File For key, Or ErrCallbackNotFound If There Is None Or It Expired
+func (s *FileCallbackStore) Load(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrCallbackNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.ttl > 0 && time.Since(info.ModTime()) > s.ttl {
+		_ = os.Remove(path)
+		return nil, ErrCallbackNotFound
+	}
+
+	return os.ReadFile(path)
+}
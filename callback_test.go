@@ -0,0 +1,224 @@
+package goTelegram
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testPayload struct {
+	Action string `json:"action"`
+	ID     int    `json:"id"`
+	Note   string `json:"note,omitempty"`
+}
+
+func TestCallbackDataRoundTrip(t *testing.T) {
+	fileStore, err := NewFileCallbackStore(t.TempDir(), time.Hour)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small := testPayload{Action: "buy", ID: 7}
+	large := testPayload{Action: "buy", ID: 7, Note: strings.Repeat("n", 100)}
+
+	tests := []struct {
+		name       string
+		store      CallbackStore
+		prefix     string
+		payload    testPayload
+		wantMarker string
+		wantErr    bool
+	}{
+		{name: "inline", prefix: "shop", payload: small, wantMarker: callbackInline},
+		{name: "stored in memory", store: NewMemoryCallbackStore(time.Hour), prefix: "shop", payload: large, wantMarker: callbackStored},
+		{name: "stored in files", store: fileStore, prefix: "shop", payload: large, wantMarker: callbackStored},
+		{name: "too large without a store", prefix: "shop", payload: large, wantErr: true},
+		{name: "prefix with a marker", prefix: "a~b", payload: small, wantErr: true},
+		{name: "prefix too long for a key", store: NewMemoryCallbackStore(time.Hour), prefix: strings.Repeat("p", 50), payload: large, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := &Bot{}
+			bot.UseCallbackPayloads(tt.store)
+
+			data, err := bot.CallbackData(tt.prefix, tt.payload)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("CallbackData = %q, want an error", data)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(data) > MaxCallbackDataLength || !strings.HasPrefix(data, tt.prefix+tt.wantMarker) {
+				t.Fatalf("CallbackData = %q (%d bytes), want %q followed by the payload", data, len(data), tt.prefix+tt.wantMarker)
+			}
+
+			update := Update{CallbackQuery: CallbackQuery{ID: "q", Data: data}}
+			bot.resolveCallback(context.Background(), &update)
+
+			var got testPayload
+
+			if err := update.CallbackQuery.DecodePayload(&got); err != nil || got != tt.payload {
+				t.Errorf("DecodePayload = %+v, %v, want %+v", got, err, tt.payload)
+			}
+		})
+	}
+}
+
+func TestCallbackDataDisabled(t *testing.T) {
+	if _, err := (&Bot{}).CallbackData("x", 1); !errors.Is(err, ErrCallbackPayloadsDisabled) {
+		t.Errorf("CallbackData returned %v, want ErrCallbackPayloadsDisabled", err)
+	}
+}
+
+func TestCallbackResolve(t *testing.T) {
+	codec := &callbackCodec{store: NewMemoryCallbackStore(time.Hour)}
+	unknownKey := strings.Repeat("A", 22)
+
+	tests := []struct {
+		name        string
+		data        string
+		wantEncoded bool
+		wantPayload string
+		wantErr     error
+	}{
+		{name: "plain data", data: "menu"},
+		{name: "inline payload", data: `menu~j:{"id":1}`, wantEncoded: true, wantPayload: `{"id":1}`},
+		{name: "inline payload without prefix", data: `~j:[1,2]`, wantEncoded: true, wantPayload: `[1,2]`},
+		{name: "marker after another tilde", data: `a~b~j:{"id":1}`},
+		{name: "marker later in the data", data: `note~ ~j:{"id":1}`},
+		{name: "inline marker with invalid json", data: "menu~j:not json"},
+		{name: "stored marker with a short key", data: "menu~k:abc"},
+		{name: "stored marker with an invalid key", data: "menu~k:" + strings.Repeat("!", 22)},
+		{name: "unknown stored key", data: "menu~k:" + unknownKey, wantEncoded: true, wantErr: ErrCallbackNotFound},
+		{name: "trailing tilde", data: "menu~"},
+		{name: "truncated marker", data: "menu~j"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, encoded, err := codec.resolve(context.Background(), tt.data)
+
+			if encoded != tt.wantEncoded || !errors.Is(err, tt.wantErr) || string(raw) != tt.wantPayload {
+				t.Errorf("resolve(%q) = %q, %v, %v, want %q, %v, %v", tt.data, raw, encoded, err, tt.wantPayload, tt.wantEncoded, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCallbackStores(t *testing.T) {
+	fileStore, err := NewFileCallbackStore(t.TempDir(), 50*time.Millisecond)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stores := []struct {
+		name  string
+		store CallbackStore
+	}{
+		{name: "memory", store: NewMemoryCallbackStore(50 * time.Millisecond)},
+		{name: "file", store: fileStore},
+	}
+
+	for _, tt := range stores {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := tt.store.Save(ctx, "key", []byte(`{"a":1}`)); err != nil {
+				t.Fatal(err)
+			}
+
+			if data, err := tt.store.Load(ctx, "key"); err != nil || string(data) != `{"a":1}` {
+				t.Fatalf("Load = %q, %v", data, err)
+			}
+
+			if _, err := tt.store.Load(ctx, "missing"); !errors.Is(err, ErrCallbackNotFound) {
+				t.Errorf("Load of a missing key returned %v", err)
+			}
+
+			time.Sleep(80 * time.Millisecond)
+
+			if _, err := tt.store.Load(ctx, "key"); !errors.Is(err, ErrCallbackNotFound) {
+				t.Errorf("Load after the ttl returned %v, want ErrCallbackNotFound", err)
+			}
+		})
+	}
+}
+
+func TestFileCallbackStoreRejectsPaths(t *testing.T) {
+	store, err := NewFileCallbackStore(t.TempDir(), 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"", "../escape", `a\b`, "a/b", "a.b"} {
+		if err := store.Save(context.Background(), key, []byte("{}")); err == nil {
+			t.Errorf("Save accepted key %q", key)
+		}
+	}
+}
+
+func TestFileCallbackStoreSweep(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-2 * time.Hour)
+
+	for _, name := range []string{"stale.json", "save-123", "fresh.json", "other.txt"} {
+		path := filepath.Join(dir, name)
+
+		if err := os.WriteFile(path, []byte("{}"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		if name != "fresh.json" {
+			if err := os.Chtimes(path, old, old); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	store, err := NewFileCallbackStore(dir, time.Hour)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	for name, want := range map[string]bool{"stale.json": false, "save-123": false, "fresh.json": true, "other.txt": true} {
+		if got := exists(name); got != want {
+			t.Errorf("after creating the store, %s exists = %v, want %v", name, got, want)
+		}
+	}
+
+	// Expire fresh.json, Then Make The Next Save The 1024th So It Sweeps Again
+	if err := os.Chtimes(filepath.Join(dir, "fresh.json"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	store.saves.Store(1023)
+
+	if err := store.Save(context.Background(), "new", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+
+	if exists("fresh.json") || !exists("new.json") {
+		t.Errorf("1024th save didn't sweep: fresh.json exists = %v, new.json exists = %v", exists("fresh.json"), exists("new.json"))
+	}
+}
@@ -0,0 +1,267 @@
+package goTelegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrQueueFull : Returned When An Update Couldn't Be Queued Before The Deadline
+var ErrQueueFull = errors.New("update Queue Is Full")
+
+// ErrBotShutdown : Returned When An Update Arrives After Shutdown Was Called
+var ErrBotShutdown = errors.New("bot Is Shutting Down")
+
+// DispatchMode : How Updates Are Ordered Relative To Each Other
+type DispatchMode int
+
+const (
+	// DispatchConcurrent : Every Update Is Handled As Soon As A Worker Is Free
+	DispatchConcurrent DispatchMode = iota
+	// DispatchPerChat : Updates From The Same Chat Are Handled One At A Time, In The Order They Arrived
+	DispatchPerChat
+	// DispatchPerUser : Updates From The Same User Are Handled One At A Time, In The Order They Arrived
+	DispatchPerUser
+)
+
+// DispatchOptions : Controls How Incoming Updates Are Handed To Handlers
+type DispatchOptions struct {
+	// Mode : Ordering Guarantee Between Updates, Defaults To DispatchConcurrent
+	Mode DispatchMode
+	// Workers : Maximum Number Of Handlers Running At Once, Defaults To 64
+	Workers int
+	// QueueSize : Updates Held While Every Worker Is Busy, Defaults To 1024
+	// Once It Fills Up, Polling Pauses And The Webhook Answers 503 So Telegram Retries Later
+	QueueSize int
+	// WebhookWait : How Long The Webhook Waits For Room In A Full Queue Before Answering 503, Defaults To 1 Second
+	WebhookWait time.Duration
+	// OnError : Called When A Handler Panics, Defaults To Logging The Panic
+	OnError func(err error, update Update)
+}
+
+// PanicError : A Panic Recovered From A Handler
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("handler Panicked: %v", e.Value)
+}
+
+type dispatchJob struct {
+	key     int
+	update  Update
+	handler Handler
+}
+
+type dispatcher struct {
+	options DispatchOptions
+	queue   chan dispatchJob
+	slots   chan struct{}
+	start   sync.Once
+	mu      sync.Mutex
+	// pending : Jobs Waiting Behind One Already Running For The Same Key
+	// A Key Is Present While Its Chat Or User Has Work In Flight, And Removed Once It Goes Idle
+	pending map[int][]dispatchJob
+	closed  bool
+	done    chan struct{}
+	drained sync.Once
+	active  sync.WaitGroup
+	running atomic.Int64
+}
+
+func newDispatcher(options DispatchOptions) *dispatcher {
+	if options.Workers <= 0 {
+		options.Workers = 64
+	}
+
+	if options.QueueSize <= 0 {
+		options.QueueSize = 1024
+	}
+
+	if options.WebhookWait <= 0 {
+		options.WebhookWait = time.Second
+	}
+
+	if options.OnError == nil {
+		options.OnError = func(err error, update Update) {
+			log.Printf("Error While Handling Update %d: %v", update.UpdateID, err)
+
+			var panicErr *PanicError
+
+			if errors.As(err, &panicErr) {
+				log.Printf("%s", panicErr.Stack)
+			}
+		}
+	}
+
+	return &dispatcher{
+		options: options,
+		queue:   make(chan dispatchJob, options.QueueSize),
+		slots:   make(chan struct{}, options.QueueSize),
+		pending: make(map[int][]dispatchJob),
+		done:    make(chan struct{}),
+	}
+}
+
+// SetDispatchOptions : Change How Updates Are Dispatched
+// It Must Be Called Before Any Update Is Received
+func (b *Bot) SetDispatchOptions(options DispatchOptions) {
+	b.dispatcher = newDispatcher(options)
+}
+
+// dispatch : Queue update For A Worker, Waiting Until ctx Is Done If The Queue Is Full
+func (b *Bot) dispatch(ctx context.Context, update Update) error {
+	if b.dispatcher == nil {
+		b.dispatcher = newDispatcher(DispatchOptions{})
+	}
+
+	d := b.dispatcher
+
+	if d.isClosed() {
+		return ErrBotShutdown
+	}
+
+	d.start.Do(func() {
+		for i := 0; i < d.options.Workers; i++ {
+			go d.work()
+		}
+	})
+
+	job := dispatchJob{key: d.key(update), update: update, handler: b.handleUpdate}
+
+	select {
+	case d.slots <- struct{}{}:
+	default:
+		select {
+		case d.slots <- struct{}{}:
+		case <-d.done:
+			return ErrBotShutdown
+		case <-ctx.Done():
+			return ErrQueueFull
+		}
+	}
+
+	d.mu.Lock()
+
+	if d.closed {
+		d.mu.Unlock()
+		<-d.slots
+		return ErrBotShutdown
+	}
+
+	d.active.Add(1)
+
+	if job.key != 0 {
+		if waiting, busy := d.pending[job.key]; busy {
+			d.pending[job.key] = append(waiting, job)
+			d.mu.Unlock()
+			return nil
+		}
+
+		d.pending[job.key] = nil
+	}
+
+	d.mu.Unlock()
+
+	d.queue <- job
+
+	return nil
+}
+
+func (d *dispatcher) key(update Update) int {
+	switch d.options.Mode {
+	case DispatchPerChat:
+		return updateChat(update)
+	case DispatchPerUser:
+		return updateSender(update)
+	default:
+		return 0
+	}
+}
+
+func (d *dispatcher) work() {
+	for job := range d.queue {
+		for {
+			<-d.slots
+
+			d.running.Add(1)
+			d.run(job)
+			d.running.Add(-1)
+			d.active.Done()
+
+			if job.key == 0 {
+				break
+			}
+
+			next, ok := d.next(job.key)
+
+			if !ok {
+				break
+			}
+
+			job = next
+		}
+	}
+}
+
+// next : Pop The Next Job Waiting On key, Forgetting The Key Once Nothing Is Left
+func (d *dispatcher) next(key int) (dispatchJob, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	waiting := d.pending[key]
+
+	if len(waiting) == 0 {
+		delete(d.pending, key)
+		return dispatchJob{}, false
+	}
+
+	d.pending[key] = waiting[1:]
+
+	return waiting[0], true
+}
+
+func (d *dispatcher) run(job dispatchJob) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.options.OnError(&PanicError{Value: r, Stack: debug.Stack()}, job.update)
+		}
+	}()
+
+	job.handler(job.update)
+}
+
+func (d *dispatcher) isClosed() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.closed
+}
+
+// close : Stop Accepting Updates, Reporting Whether It Was Already Closed
+func (d *dispatcher) close() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return true
+	}
+
+	d.closed = true
+	close(d.done)
+
+	return false
+}
+
+// stop : Close The Queue So Idle Workers Exit
+// It Must Only Be Called Once close Has Returned And active Has Drained, As Nothing Can Be Queued After That
+func (d *dispatcher) stop() {
+	d.drained.Do(func() { close(d.queue) })
+}
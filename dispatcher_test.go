@@ -0,0 +1,246 @@
+package goTelegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// postUpdate : Deliver body To The Bot's Webhook, Returning The Status It Answered With
+func postUpdate(bot *Bot, body string) int {
+	recorder := httptest.NewRecorder()
+	bot.UpdateHandler(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	return recorder.Code
+}
+
+func TestWebhookAnswers503WhenQueueIsFull(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{
+		Dispatch: DispatchOptions{Workers: 1, QueueSize: 1, WebhookWait: 50 * time.Millisecond},
+	})
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+
+	bot.SetHandler(func(Update) {
+		started <- struct{}{}
+		<-release
+	})
+
+	defer close(release)
+
+	// The First Update Holds The Only Worker, The Second Fills The Queue
+	if code := postUpdate(bot, `{"update_id":1}`); code != http.StatusOK {
+		t.Fatalf("first update answered %d", code)
+	}
+
+	<-started
+
+	if code := postUpdate(bot, `{"update_id":2}`); code != http.StatusOK {
+		t.Fatalf("second update answered %d", code)
+	}
+
+	start := time.Now()
+	code := postUpdate(bot, `{"update_id":3}`)
+
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("update with a full queue answered %d, want 503", code)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("webhook held the request for %s with a full queue", elapsed)
+	}
+}
+
+func TestDispatchRecoversPanics(t *testing.T) {
+	reported := make(chan error, 1)
+
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{
+		Dispatch: DispatchOptions{OnError: func(err error, update Update) { reported <- err }},
+	})
+
+	bot.SetHandler(func(Update) { panic("boom") })
+
+	if err := bot.dispatch(context.Background(), Update{UpdateID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-reported:
+		var panicErr *PanicError
+
+		if !errors.As(err, &panicErr) || panicErr.Value != "boom" || len(panicErr.Stack) == 0 {
+			t.Errorf("OnError got %v, want a *PanicError carrying the panic", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("panic wasn't reported")
+	}
+}
+
+func TestDispatchLimitsWorkers(t *testing.T) {
+	tests := []struct {
+		workers int
+		updates int
+	}{
+		{workers: 1, updates: 10},
+		{workers: 3, updates: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d workers", tt.workers), func(t *testing.T) {
+			bot := newTestBot(t, newFakeAPI(t), BotOptions{Dispatch: DispatchOptions{Workers: tt.workers}})
+
+			var running, peak atomic.Int64
+			var wg sync.WaitGroup
+
+			wg.Add(tt.updates)
+
+			bot.SetHandler(func(Update) {
+				defer wg.Done()
+
+				n := running.Add(1)
+
+				for {
+					p := peak.Load()
+
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+			})
+
+			for i := 0; i < tt.updates; i++ {
+				if err := bot.dispatch(context.Background(), Update{UpdateID: i}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			wg.Wait()
+
+			if p := peak.Load(); p > int64(tt.workers) {
+				t.Errorf("%d handlers ran at once, the limit is %d", p, tt.workers)
+			}
+		})
+	}
+}
+
+func TestDispatchOrdering(t *testing.T) {
+	const keys, perKey = 3, 20
+
+	tests := []struct {
+		name   string
+		mode   DispatchMode
+		update func(id, key int) Update
+	}{
+		{
+			name: "per chat",
+			mode: DispatchPerChat,
+			update: func(id, key int) Update {
+				return Update{UpdateID: id, Message: Message{Chat: Chat{ID: key}, From: User{ID: 1}}}
+			},
+		},
+		{
+			name: "per user",
+			mode: DispatchPerUser,
+			update: func(id, key int) Update {
+				return Update{UpdateID: id, Message: Message{Chat: Chat{ID: -100}, From: User{ID: key}}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := newTestBot(t, newFakeAPI(t), BotOptions{Dispatch: DispatchOptions{Mode: tt.mode, Workers: 8}})
+
+			var (
+				mu      sync.Mutex
+				seen    = make(map[int][]int)
+				busy    = make(map[int]bool)
+				running int
+				peak    int
+				wg      sync.WaitGroup
+			)
+
+			wg.Add(keys * perKey)
+
+			bot.SetHandler(func(update Update) {
+				defer wg.Done()
+
+				key := updateChat(update)
+
+				if tt.mode == DispatchPerUser {
+					key = updateSender(update)
+				}
+
+				mu.Lock()
+
+				if busy[key] {
+					t.Errorf("two updates for key %d ran at once", key)
+				}
+
+				busy[key] = true
+				running++
+				peak = max(peak, running)
+				seen[key] = append(seen[key], update.UpdateID)
+				mu.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				busy[key] = false
+				running--
+				mu.Unlock()
+			})
+
+			// Updates For Each Key Are Interleaved, And Numbered So Their Arrival Order Can Be Checked
+			for i := 0; i < keys*perKey; i++ {
+				if err := bot.dispatch(context.Background(), tt.update(i, i%keys+1)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			wg.Wait()
+
+			for key, ids := range seen {
+				for i := 1; i < len(ids); i++ {
+					if ids[i] < ids[i-1] {
+						t.Fatalf("key %d handled out of order: %v", key, ids)
+					}
+				}
+			}
+
+			if peak < 2 {
+				t.Errorf("updates for different keys never ran in parallel")
+			}
+
+			// The Key Is Forgotten Just After Its Last Handler Returns, So Give The Worker A Moment
+			deadline := time.Now().Add(time.Second)
+
+			for {
+				bot.dispatcher.mu.Lock()
+				left := len(bot.dispatcher.pending)
+				bot.dispatcher.mu.Unlock()
+
+				if left == 0 {
+					break
+				}
+
+				if time.Now().After(deadline) {
+					t.Fatalf("%d keys still pending after every update was handled", left)
+				}
+
+				time.Sleep(time.Millisecond)
+			}
+		})
+	}
+}
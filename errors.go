@@ -0,0 +1,104 @@
+package goTelegram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidToken : Returned By NewBot When Telegram Doesn't Recognise The Token, It Wraps The *APIError Received
+var ErrInvalidToken = errors.New("invalid Bot Token Provided")
+
+// APIError : Error Returned By Telegram When A Request Fails
+type APIError struct {
+	ErrorCode   int                `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// ResponseParameters : Extra Information Telegram Attaches To Some Errors
+type ResponseParameters struct {
+	MigrateToChatID int `json:"migrate_to_chat_id,omitempty"`
+	RetryAfter      int `json:"retry_after,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram: %d %s", e.ErrorCode, e.Description)
+}
+
+// newAPIError : Decode The Body Of A Failed Request Into An *APIError
+// It Falls Back To A Plain Error When The Body Isn't A Telegram Error Response
+func newAPIError(statusCode int, body []byte) error {
+	apiErr := &APIError{}
+
+	err := json.Unmarshal(body, apiErr)
+
+	if err != nil || apiErr.Description == "" {
+		return errors.New(string(body))
+	}
+
+	if apiErr.ErrorCode == 0 {
+		apiErr.ErrorCode = statusCode
+	}
+
+	return apiErr
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	return nil, false
+}
+
+func hasDescription(err error, code int, substrings ...string) bool {
+	apiErr, ok := asAPIError(err)
+
+	if !ok || (code != 0 && apiErr.ErrorCode != code) {
+		return false
+	}
+
+	description := strings.ToLower(apiErr.Description)
+
+	for _, s := range substrings {
+		if strings.Contains(description, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBlocked : Report Whether The User Blocked The Bot Or Deactivated Their Account
+func IsBlocked(err error) bool {
+	return hasDescription(err, 403, "bot was blocked by the user", "user is deactivated", "bot was kicked")
+}
+
+// IsRateLimited : Report Whether Telegram Asked Us To Slow Down
+func IsRateLimited(err error) bool {
+	apiErr, ok := asAPIError(err)
+
+	return ok && (apiErr.ErrorCode == 429 || apiErr.Parameters.RetryAfter > 0)
+}
+
+// IsMessageNotModified : Report Whether An Edit Failed Because Nothing Changed
+func IsMessageNotModified(err error) bool {
+	return hasDescription(err, 400, "message is not modified")
+}
+
+// IsChatNotFound : Report Whether The Target Chat Doesn't Exist Or Is Inaccessible
+func IsChatNotFound(err error) bool {
+	return hasDescription(err, 400, "chat not found")
+}
+
+// IsChatMigrated : Report Whether A Group Was Upgraded To A Supergroup
+// The New Chat ID Is Available In APIError.Parameters.MigrateToChatID
+func IsChatMigrated(err error) bool {
+	apiErr, ok := asAPIError(err)
+
+	return ok && apiErr.Parameters.MigrateToChatID != 0
+}
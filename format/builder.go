@@ -0,0 +1,277 @@
+package format
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/h4ckitt/goTelegram"
+)
+
+type style int
+
+const (
+	plain style = iota
+	bold
+	italic
+	underline
+	strikethrough
+	code
+	pre
+	link
+	mention
+	spoiler
+	blockquote
+)
+
+var entityTypes = map[style]string{
+	bold:          "bold",
+	italic:        "italic",
+	underline:     "underline",
+	strikethrough: "strikethrough",
+	code:          "code",
+	pre:           "pre",
+	link:          "text_link",
+	mention:       "text_mention",
+	spoiler:       "spoiler",
+	blockquote:    "blockquote",
+}
+
+type part struct {
+	style    style
+	text     string
+	url      string
+	userID   int
+	language string
+}
+
+// Builder : Assembles A Message Out Of Plain And Formatted Pieces
+// The Same Message Can Be Rendered For The MarkdownV2 Or HTML Parse Modes, Or As Entities
+// Pieces Are Escaped While Rendering, So Any Text, Including User Input, Can Be Passed In
+type Builder struct {
+	parts      []part
+	afterQuote bool
+}
+
+// New : Create An Empty Builder
+func New() *Builder {
+	return &Builder{}
+}
+
+func (b *Builder) add(p part) *Builder {
+	if b.afterQuote && !strings.HasPrefix(p.text, "\n") {
+		b.parts = append(b.parts, part{text: "\n"})
+	}
+
+	b.afterQuote = false
+	b.parts = append(b.parts, p)
+
+	return b
+}
+
+// Text : Append Unformatted Text
+func (b *Builder) Text(s string) *Builder {
+	return b.add(part{text: s})
+}
+
+// Bold : Append Bold Text
+func (b *Builder) Bold(s string) *Builder {
+	return b.add(part{style: bold, text: s})
+}
+
+// Italic : Append Italic Text
+func (b *Builder) Italic(s string) *Builder {
+	return b.add(part{style: italic, text: s})
+}
+
+// Underline : Append Underlined Text
+func (b *Builder) Underline(s string) *Builder {
+	return b.add(part{style: underline, text: s})
+}
+
+// Strikethrough : Append Struck Through Text
+func (b *Builder) Strikethrough(s string) *Builder {
+	return b.add(part{style: strikethrough, text: s})
+}
+
+// Code : Append Inline Monospace Text
+func (b *Builder) Code(s string) *Builder {
+	return b.add(part{style: code, text: s})
+}
+
+// Pre : Append A Code Block, language Can Be Left Empty
+func (b *Builder) Pre(s, language string) *Builder {
+	return b.add(part{style: pre, text: s, language: language})
+}
+
+// Link : Append text Linking To url
+func (b *Builder) Link(text, url string) *Builder {
+	return b.add(part{style: link, text: text, url: url})
+}
+
+// Mention : Append text Linking To The User With The Given ID, Which Works Even If They Have No Username
+func (b *Builder) Mention(text string, userID int) *Builder {
+	return b.add(part{style: mention, text: text, userID: userID})
+}
+
+// Spoiler : Append Text Hidden Until Tapped
+func (b *Builder) Spoiler(s string) *Builder {
+	return b.add(part{style: spoiler, text: s})
+}
+
+// Blockquote : Append A Quotation
+// A Quote Always Takes Up Whole Lines, So A Line Break Is Added Before And After It When Needed
+func (b *Builder) Blockquote(s string) *Builder {
+	if n := len(b.parts); n > 0 && !strings.HasSuffix(b.parts[n-1].text, "\n") {
+		b.add(part{text: "\n"})
+	}
+
+	b.add(part{style: blockquote, text: s})
+	b.afterQuote = true
+
+	return b
+}
+
+// String : The Message Text Without Any Formatting
+func (b *Builder) String() string {
+	var sb strings.Builder
+
+	for _, p := range b.parts {
+		sb.WriteString(p.text)
+	}
+
+	return sb.String()
+}
+
+// MarkdownV2 : Render The Message For The MarkdownV2 Parse Mode
+func (b *Builder) MarkdownV2() string {
+	var sb strings.Builder
+
+	for i, p := range b.parts {
+		opening, body, closing := markdownV2Part(p)
+
+		// "__" Is Always Read As Underline, So An Empty Bold Entity Separates An Italic Marker From An Underline One
+		if i > 0 && strings.HasSuffix(sb.String(), "_") && strings.HasPrefix(opening, "_") && b.parts[i-1].style != plain {
+			sb.WriteString("**")
+		}
+
+		sb.WriteString(opening)
+		sb.WriteString(body)
+		sb.WriteString(closing)
+	}
+
+	return sb.String()
+}
+
+func markdownV2Part(p part) (string, string, string) {
+	switch p.style {
+	case bold:
+		return "*", EscapeMarkdownV2(p.text), "*"
+	case italic:
+		return "_", EscapeMarkdownV2(p.text), "_"
+	case underline:
+		return "__", EscapeMarkdownV2(p.text), "__"
+	case strikethrough:
+		return "~", EscapeMarkdownV2(p.text), "~"
+	case code:
+		return "`", markdownCodeEscape.Replace(p.text), "`"
+	case pre:
+		return "```" + p.language + "\n", markdownCodeEscape.Replace(p.text), "\n```"
+	case link:
+		return "[", EscapeMarkdownV2(p.text), "](" + markdownURLEscape.Replace(p.url) + ")"
+	case mention:
+		return "[", EscapeMarkdownV2(p.text), "](tg://user?id=" + strconv.Itoa(p.userID) + ")"
+	case spoiler:
+		return "||", EscapeMarkdownV2(p.text), "||"
+	case blockquote:
+		lines := strings.Split(p.text, "\n")
+
+		for i, line := range lines {
+			lines[i] = ">" + EscapeMarkdownV2(line)
+		}
+
+		return "", strings.Join(lines, "\n"), ""
+	default:
+		return "", EscapeMarkdownV2(p.text), ""
+	}
+}
+
+// HTML : Render The Message For The HTML Parse Mode
+func (b *Builder) HTML() string {
+	var sb strings.Builder
+
+	for _, p := range b.parts {
+		text := EscapeHTML(p.text)
+
+		switch p.style {
+		case bold:
+			sb.WriteString("<b>" + text + "</b>")
+		case italic:
+			sb.WriteString("<i>" + text + "</i>")
+		case underline:
+			sb.WriteString("<u>" + text + "</u>")
+		case strikethrough:
+			sb.WriteString("<s>" + text + "</s>")
+		case code:
+			sb.WriteString("<code>" + text + "</code>")
+		case pre:
+			if p.language == "" {
+				sb.WriteString("<pre>" + text + "</pre>")
+			} else {
+				sb.WriteString(`<pre><code class="language-` + EscapeHTML(p.language) + `">` + text + "</code></pre>")
+			}
+		case link:
+			sb.WriteString(`<a href="` + EscapeHTML(p.url) + `">` + text + "</a>")
+		case mention:
+			sb.WriteString(`<a href="tg://user?id=` + strconv.Itoa(p.userID) + `">` + text + "</a>")
+		case spoiler:
+			sb.WriteString("<tg-spoiler>" + text + "</tg-spoiler>")
+		case blockquote:
+			sb.WriteString("<blockquote>" + text + "</blockquote>")
+		default:
+			sb.WriteString(text)
+		}
+	}
+
+	return sb.String()
+}
+
+// Entities : The Message Text Along With The Entities Formatting It
+// Offsets Are In UTF-16 Code Units, So The Result Can Be Passed Straight To MessageOptions
+func (b *Builder) Entities() (string, []goTelegram.MessageEntity) {
+	var (
+		sb       strings.Builder
+		entities []goTelegram.MessageEntity
+		offset   int
+	)
+
+	for _, p := range b.parts {
+		length := UTF16Len(p.text)
+
+		sb.WriteString(p.text)
+
+		if p.style != plain && length > 0 {
+			entity := goTelegram.MessageEntity{
+				Type:     entityTypes[p.style],
+				Offset:   offset,
+				Length:   length,
+				URL:      p.url,
+				Language: p.language,
+			}
+
+			if p.style == mention {
+				entity.User = &goTelegram.User{ID: p.userID}
+			}
+
+			entities = append(entities, entity)
+		}
+
+		offset += length
+	}
+
+	return sb.String(), entities
+}
+
+// UTF16Len : Length Of s In UTF-16 Code Units, Same As goTelegram.UTF16Len
+func UTF16Len(s string) int {
+	return goTelegram.UTF16Len(s)
+}
@@ -0,0 +1,168 @@
+package format
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/h4ckitt/goTelegram"
+)
+
+func TestSplitBuiltMessages(t *testing.T) {
+	link := New().Link("click", "http://a.b")
+	escaped := New().Text("!!!")
+	mixed := New().Bold("bold").Text(" & ").Link("x", "http://a.b")
+
+	tests := []struct {
+		name      string
+		text      string
+		parseMode string
+	}{
+		{name: "markdown link", text: link.MarkdownV2(), parseMode: goTelegram.ParseModeMarkdownV2},
+		{name: "html link", text: link.HTML(), parseMode: goTelegram.ParseModeHTML},
+		{name: "markdown escapes", text: escaped.MarkdownV2(), parseMode: goTelegram.ParseModeMarkdownV2},
+		{name: "mixed markdown", text: mixed.MarkdownV2(), parseMode: goTelegram.ParseModeMarkdownV2},
+		{name: "mixed html", text: mixed.HTML(), parseMode: goTelegram.ParseModeHTML},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := goTelegram.SplitMessage(tt.text, tt.parseMode, nil, goTelegram.MaxMessageLength)
+
+			if err != nil || len(parts) != 1 || parts[0].Text != tt.text {
+				t.Errorf("SplitMessage(%q) = %#v, %v, want the text as a single part", tt.text, parts, err)
+			}
+		})
+	}
+}
+
+func TestMarkdownV2(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *Builder
+		want    string
+	}{
+		{name: "text is escaped", builder: New().Text("1.5!"), want: `1\.5\!`},
+		{name: "styles", builder: New().Bold("b").Italic("i").Strikethrough("s").Spoiler("p"), want: "*b*_i_~s~||p||"},
+		{name: "code escapes only backticks and backslashes", builder: New().Code("a`b\\c.d"), want: "`a\\`b\\\\c.d`"},
+		{name: "pre with language", builder: New().Pre("x := `1`", "go"), want: "```go\nx := \\`1\\`\n```"},
+		{name: "link text and url", builder: New().Link("a [b]", `http://x.com/(y)\z`), want: `[a \[b\]](http://x.com/(y\)\\z)`},
+		{name: "mention", builder: New().Mention("Ann", 42), want: "[Ann](tg://user?id=42)"},
+		{name: "blockquote lines", builder: New().Text("a").Blockquote("q1\nq.2").Text("b"), want: "a\n>q1\n>q\\.2\nb"},
+		{name: "italic then underline", builder: New().Italic("i").Underline("u"), want: "_i_**__u__"},
+		{name: "underline then italic", builder: New().Underline("u").Italic("i"), want: "__u__**_i_"},
+		{name: "italic then text then underline", builder: New().Italic("i").Text(" ").Underline("u"), want: "_i_ __u__"},
+		{name: "bold then italic", builder: New().Bold("b").Italic("i"), want: "*b*_i_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.builder.MarkdownV2(); got != tt.want {
+				t.Errorf("MarkdownV2 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTML(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *Builder
+		want    string
+	}{
+		{name: "text is escaped", builder: New().Text("a<b & c"), want: "a&lt;b &amp; c"},
+		{name: "styles", builder: New().Bold("b").Italic("i").Underline("u").Strikethrough("s").Spoiler("p"), want: "<b>b</b><i>i</i><u>u</u><s>s</s><tg-spoiler>p</tg-spoiler>"},
+		{name: "code", builder: New().Code("<x>"), want: "<code>&lt;x&gt;</code>"},
+		{name: "pre", builder: New().Pre("a<b", ""), want: "<pre>a&lt;b</pre>"},
+		{name: "pre with language", builder: New().Pre("a<b", `g"o`), want: `<pre><code class="language-g&quot;o">a&lt;b</code></pre>`},
+		{name: "link", builder: New().Link("a&b", `http://x.com/?q="1"&r=2`), want: `<a href="http://x.com/?q=&quot;1&quot;&amp;r=2">a&amp;b</a>`},
+		{name: "mention", builder: New().Mention("<Ann>", 42), want: `<a href="tg://user?id=42">&lt;Ann&gt;</a>`},
+		{name: "blockquote", builder: New().Blockquote("q"), want: "<blockquote>q</blockquote>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.builder.HTML(); got != tt.want {
+				t.Errorf("HTML = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntities(t *testing.T) {
+	tests := []struct {
+		name     string
+		builder  *Builder
+		wantText string
+		want     []goTelegram.MessageEntity
+	}{
+		{
+			name:     "ascii",
+			builder:  New().Text("hi ").Bold("there"),
+			wantText: "hi there",
+			want:     []goTelegram.MessageEntity{{Type: "bold", Offset: 3, Length: 5}},
+		},
+		{
+			name:     "emoji before an entity",
+			builder:  New().Text("🎉🎉 ").Italic("yay"),
+			wantText: "🎉🎉 yay",
+			want:     []goTelegram.MessageEntity{{Type: "italic", Offset: 5, Length: 3}},
+		},
+		{
+			name:     "emoji inside an entity",
+			builder:  New().Bold("a🎉b").Code("c"),
+			wantText: "a🎉bc",
+			want:     []goTelegram.MessageEntity{{Type: "bold", Offset: 0, Length: 4}, {Type: "code", Offset: 4, Length: 1}},
+		},
+		{
+			name:     "accented text is one unit per letter",
+			builder:  New().Text("café ").Underline("ü"),
+			wantText: "café ü",
+			want:     []goTelegram.MessageEntity{{Type: "underline", Offset: 5, Length: 1}},
+		},
+		{
+			name:     "link, pre and mention carry their extras",
+			builder:  New().Link("l", "http://x").Pre("p", "go").Mention("m", 7),
+			wantText: "lpm",
+			want: []goTelegram.MessageEntity{
+				{Type: "text_link", Offset: 0, Length: 1, URL: "http://x"},
+				{Type: "pre", Offset: 1, Length: 1, Language: "go"},
+				{Type: "text_mention", Offset: 2, Length: 1, User: &goTelegram.User{ID: 7}},
+			},
+		},
+		{
+			name:     "empty pieces have no entity",
+			builder:  New().Bold("").Text("x"),
+			wantText: "x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, entities := tt.builder.Entities()
+
+			if text != tt.wantText || !reflect.DeepEqual(entities, tt.want) {
+				t.Errorf("Entities = %q, %+v, want %q, %+v", text, entities, tt.wantText, tt.want)
+			}
+		})
+	}
+}
+
+func TestUTF16Len(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{in: "", want: 0},
+		{in: "abc", want: 3},
+		{in: "é", want: 1},
+		{in: "🎉", want: 2},
+		{in: "a🎉b", want: 4},
+		{in: "👨‍👩‍👧", want: 8},
+	}
+
+	for _, tt := range tests {
+		if got := UTF16Len(tt.in); got != tt.want {
+			t.Errorf("UTF16Len(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Package format : Build Formatted Telegram Messages Without Worrying About Escaping
+package format
+
+import "strings"
+
+var (
+	markdownV2Replacer = newEscaper("_*[]()~`>#+-=|{}.!\\")
+	markdownCodeEscape = newEscaper("`\\")
+	markdownURLEscape  = newEscaper(")\\")
+	htmlReplacer       = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+func newEscaper(chars string) *strings.Replacer {
+	pairs := make([]string, 0, len(chars)*2)
+
+	for _, c := range chars {
+		pairs = append(pairs, string(c), "\\"+string(c))
+	}
+
+	return strings.NewReplacer(pairs...)
+}
+
+// EscapeMarkdownV2 : Escape Every Character MarkdownV2 Treats As Special So s Is Shown As-Is
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Replacer.Replace(s)
+}
+
+// EscapeHTML : Escape s For Use As Text Or An Attribute Value With The HTML Parse Mode
+func EscapeHTML(s string) string {
+	return htmlReplacer.Replace(s)
+}
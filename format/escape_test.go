@@ -0,0 +1,46 @@
+package format
+
+import "testing"
+
+func TestEscapeMarkdownV2(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "plain text", want: "plain text"},
+		{in: "1.5 + 2 = 3.5!", want: `1\.5 \+ 2 \= 3\.5\!`},
+		{in: "_*[]()~`>#+-=|{}.!", want: "\\_\\*\\[\\]\\(\\)\\~\\`\\>\\#\\+\\-\\=\\|\\{\\}\\.\\!"},
+		{in: `back\slash`, want: `back\\slash`},
+		{in: "emoji 🎉 stays", want: "emoji 🎉 stays"},
+		{in: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := EscapeMarkdownV2(tt.in); got != tt.want {
+				t.Errorf("EscapeMarkdownV2(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeHTML(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "plain text", want: "plain text"},
+		{in: "a < b && c > d", want: "a &lt; b &amp;&amp; c &gt; d"},
+		{in: `say "hi"`, want: "say &quot;hi&quot;"},
+		{in: "&amp;", want: "&amp;amp;"},
+		{in: "it's *fine*", want: "it's *fine*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := EscapeHTML(tt.in); got != tt.want {
+				t.Errorf("EscapeHTML(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
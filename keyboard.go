@@ -1,44 +1,103 @@
 package goTelegram
 
+import "sync"
+
+// keyboardManager : Per-Chat Keyboards, Safe For Use By Concurrent Handlers
 type keyboardManager struct {
+	mu        sync.RWMutex
 	keyboards map[int]*chatKeyboard
 }
 
 type chatKeyboard struct {
 	maxColumns int
 	keyboard   keyboard
+	// reply : Set When The Chat Has A Reply Keyboard Instead Of An Inline One
+	reply        *ReplyKeyboardOptions
+	replyButtons []KeyboardButton
+	// markup : Set With SetReplyMarkup, Takes Priority Over Any Buttons Added
+	markup ReplyMarkup
 }
 
 func newKeyboardManager() *keyboardManager {
-	return &keyboardManager{make(map[int]*chatKeyboard)}
+	return &keyboardManager{keyboards: make(map[int]*chatKeyboard)}
 }
 
+// CreateKeyboard : Start An Inline Keyboard For chatID, Replacing A Reply Keyboard If The Chat Has One
 func (k *keyboardManager) CreateKeyboard(chatID, maxCol int) {
-	_, exists := k.keyboards[chatID]
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	chatKbd, exists := k.keyboards[chatID]
+
+	if !exists {
+		k.keyboards[chatID] = &chatKeyboard{maxColumns: maxCol}
+		return
+	}
+
+	if chatKbd.reply != nil {
+		chatKbd.maxColumns, chatKbd.reply, chatKbd.replyButtons = maxCol, nil, nil
+	}
+}
+
+// CreateReplyKeyboard : Start A Reply Keyboard For chatID, Replacing An Inline Keyboard If The Chat Has One
+func (k *keyboardManager) CreateReplyKeyboard(chatID, maxCol int, options ReplyKeyboardOptions) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	chatKbd, exists := k.keyboards[chatID]
 
 	if !exists {
 		k.keyboards[chatID] = &chatKeyboard{
 			maxColumns: maxCol,
-			keyboard:   keyboard{Keyboard: [][]InlineKeyboard{}},
+			reply:      &options,
 		}
+
+		return
+	}
+
+	if chatKbd.reply == nil {
+		chatKbd.maxColumns, chatKbd.reply, chatKbd.keyboard.Buttons = maxCol, &options, nil
+	}
+}
+
+func (k *keyboardManager) SetMarkup(chatID int, markup ReplyMarkup) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	chatKbd, exists := k.keyboards[chatID]
+
+	if !exists {
+		chatKbd = &chatKeyboard{}
+		k.keyboards[chatID] = chatKbd
 	}
+
+	chatKbd.markup = markup
 }
 
 func (k *keyboardManager) HasKeyboard(chatID int) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
 	chatKbd, exists := k.keyboards[chatID]
 
 	if exists {
-		return len(chatKbd.keyboard.Buttons) > 0
+		return chatKbd.markup != nil || len(chatKbd.keyboard.Buttons) > 0 || len(chatKbd.replyButtons) > 0
 	}
 
 	return false
 }
 
 func (k *keyboardManager) DeleteKeyboard(chatID int) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
 	delete(k.keyboards, chatID)
 }
 
 func (k *keyboardManager) AddButton(chatID int, text, callBack string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
 	chatKbd, exists := k.keyboards[chatID]
 
 	if exists {
@@ -46,41 +105,103 @@ func (k *keyboardManager) AddButton(chatID int, text, callBack string) {
 	}
 }
 
+func (k *keyboardManager) AddInlineButton(chatID int, button InlineKeyboard) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	chatKbd, exists := k.keyboards[chatID]
+
+	if exists {
+		chatKbd.keyboard.Buttons = append(chatKbd.keyboard.Buttons, button)
+	}
+}
+
+func (k *keyboardManager) AddReplyButton(chatID int, button KeyboardButton) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	chatKbd, exists := k.keyboards[chatID]
+
+	if exists && chatKbd.reply != nil {
+		chatKbd.replyButtons = append(chatKbd.replyButtons, button)
+	}
+}
+
 func (k *keyboardManager) ClearKeyboard(chatID int) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
 	chatKbd, exists := k.keyboards[chatID]
 
 	if exists {
-		chatKbd.keyboard.Keyboard = nil
 		chatKbd.keyboard.Buttons = nil
+		chatKbd.replyButtons = nil
+		chatKbd.markup = nil
 	}
 }
 
+// ReturnKeyboard : The Chat's Inline Buttons Laid Out In Rows, Or nil If It Has No Keyboard
+// The Layout Is Built Fresh On Every Call, So Buttons Added Later Are Included And The Caller Is Free To Modify It
 func (k *keyboardManager) ReturnKeyboard(chatID int) [][]InlineKeyboard {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
 	chatKbd, exists := k.keyboards[chatID]
 
-	if len(chatKbd.keyboard.Keyboard) > 0 {
-		return chatKbd.keyboard.Keyboard
+	if !exists {
+		return nil
 	}
 
+	return chatKbd.inlineRows()
+}
+
+// Markup : The Markup Attached To Messages Sent To chatID, Or nil If It Has None
+func (k *keyboardManager) Markup(chatID int) ReplyMarkup {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	chatKbd, exists := k.keyboards[chatID]
+
 	if !exists {
 		return nil
 	}
 
-	buttons := make([]InlineKeyboard, 0)
-
-	for index, button := range chatKbd.keyboard.Buttons {
-		if (index+1)%chatKbd.maxColumns == 0 {
-			buttons = append(buttons, button)
-			chatKbd.keyboard.Keyboard = append(chatKbd.keyboard.Keyboard, buttons)
-			buttons = nil
-		} else {
-			buttons = append(buttons, button)
+	switch {
+	case chatKbd.markup != nil:
+		return chatKbd.markup
+	case chatKbd.reply != nil && len(chatKbd.replyButtons) > 0:
+		return ReplyKeyboardMarkup{
+			Keyboard:              arrangeButtons(append([]KeyboardButton(nil), chatKbd.replyButtons...), chatKbd.maxColumns),
+			IsPersistent:          chatKbd.reply.IsPersistent,
+			ResizeKeyboard:        chatKbd.reply.ResizeKeyboard,
+			OneTimeKeyboard:       chatKbd.reply.OneTimeKeyboard,
+			InputFieldPlaceholder: chatKbd.reply.InputFieldPlaceholder,
+			Selective:             chatKbd.reply.Selective,
 		}
+	case len(chatKbd.keyboard.Buttons) > 0:
+		return InlineKeyboardMarkup{InlineKeyboard: chatKbd.inlineRows()}
+	default:
+		return nil
 	}
+}
+
+// inlineRows : A Copy Of The Inline Buttons Laid Out In Rows, The Caller Must Hold The Manager's Lock
+func (c *chatKeyboard) inlineRows() [][]InlineKeyboard {
+	return arrangeButtons(append([]InlineKeyboard(nil), c.keyboard.Buttons...), c.maxColumns)
+}
+
+// arrangeButtons : Lay buttons Out In Rows Of At Most maxColumns
+func arrangeButtons[T any](buttons []T, maxColumns int) [][]T {
+	if maxColumns <= 0 {
+		maxColumns = max(len(buttons), 1)
+	}
+
+	rows := make([][]T, 0, (len(buttons)+maxColumns-1)/maxColumns)
 
-	if len(buttons) > 0 {
-		chatKbd.keyboard.Keyboard = append(chatKbd.keyboard.Keyboard, buttons)
+	for start := 0; start < len(buttons); start += maxColumns {
+		end := min(start+maxColumns, len(buttons))
+		rows = append(rows, buttons[start:end:end])
 	}
 
-	return chatKbd.keyboard.Keyboard
+	return rows
 }
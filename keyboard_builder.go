@@ -0,0 +1,259 @@
+package goTelegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// InlineKeyboardBuilder : Builds An InlineKeyboardMarkup For A Single Message
+// Unlike The Per-Chat Keyboard, Nothing Is Stored On The Bot, So The Result Is Only Attached
+// Where It Is Passed In, e.g MessageOptions.ReplyMarkup Or MediaOptions.ReplyMarkup
+// Rows And Buttons Are Addressed By Their Zero-Based Index. An Operation On One That Doesn't Exist
+// Is Skipped And Its Error Is Returned By Markup
+// A Builder Encodes To And From The Same JSON As InlineKeyboardMarkup, So Layouts Can Be Stored And Loaded
+type InlineKeyboardBuilder struct {
+	rows [][]InlineKeyboard
+	err  error
+}
+
+// NewInlineKeyboard : Start An Empty Inline Keyboard
+func NewInlineKeyboard() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Row : Add A New Row Made Up Of buttons
+func (k *InlineKeyboardBuilder) Row(buttons ...InlineKeyboard) *InlineKeyboardBuilder {
+	k.rows = append(k.rows, append([]InlineKeyboard(nil), buttons...))
+	return k
+}
+
+// Grid : Add buttons As New Rows Of cols Buttons Each, The Last Row Holding Whatever Is Left Over
+func (k *InlineKeyboardBuilder) Grid(cols int, buttons ...InlineKeyboard) *InlineKeyboardBuilder {
+	if cols <= 0 {
+		k.fail(fmt.Errorf("grid Needs At Least One Column, Got %d", cols))
+		return k
+	}
+
+	k.rows = append(k.rows, arrangeButtons(append([]InlineKeyboard(nil), buttons...), cols)...)
+
+	return k
+}
+
+// Button : Add button To The End Of The Last Row, Starting One If There Are None
+func (k *InlineKeyboardBuilder) Button(button InlineKeyboard) *InlineKeyboardBuilder {
+	if len(k.rows) == 0 {
+		return k.Row(button)
+	}
+
+	return k.Append(len(k.rows)-1, button)
+}
+
+// Append : Add buttons To The End Of Row row
+func (k *InlineKeyboardBuilder) Append(row int, buttons ...InlineKeyboard) *InlineKeyboardBuilder {
+	if !k.hasRow(row) {
+		return k
+	}
+
+	k.rows[row] = append(k.rows[row], buttons...)
+
+	return k
+}
+
+// Insert : Put button At Position col Of Row row, Shifting The Buttons After It Along
+// col Can Be One Past The Last Button To Add It To The End Of The Row
+func (k *InlineKeyboardBuilder) Insert(row, col int, button InlineKeyboard) *InlineKeyboardBuilder {
+	if !k.hasRow(row) {
+		return k
+	}
+
+	if col < 0 || col > len(k.rows[row]) {
+		k.fail(fmt.Errorf("can't Insert At Column %d Of Row %d, It Has %d Buttons", col, row, len(k.rows[row])))
+		return k
+	}
+
+	k.rows[row] = append(k.rows[row][:col], append([]InlineKeyboard{button}, k.rows[row][col:]...)...)
+
+	return k
+}
+
+// Remove : Take Out The Button At Position col Of Row row, Dropping The Row If It Ends Up Empty
+func (k *InlineKeyboardBuilder) Remove(row, col int) *InlineKeyboardBuilder {
+	if !k.hasButton(row, col) {
+		return k
+	}
+
+	k.rows[row] = append(k.rows[row][:col], k.rows[row][col+1:]...)
+
+	if len(k.rows[row]) == 0 {
+		k.rows = append(k.rows[:row], k.rows[row+1:]...)
+	}
+
+	return k
+}
+
+// Replace : Swap The Button At Position col Of Row row For button
+func (k *InlineKeyboardBuilder) Replace(row, col int, button InlineKeyboard) *InlineKeyboardBuilder {
+	if !k.hasButton(row, col) {
+		return k
+	}
+
+	k.rows[row][col] = button
+
+	return k
+}
+
+func (k *InlineKeyboardBuilder) hasRow(row int) bool {
+	if row < 0 || row >= len(k.rows) {
+		k.fail(fmt.Errorf("keyboard Has No Row %d, It Has %d Rows", row, len(k.rows)))
+		return false
+	}
+
+	return true
+}
+
+func (k *InlineKeyboardBuilder) hasButton(row, col int) bool {
+	if !k.hasRow(row) {
+		return false
+	}
+
+	if col < 0 || col >= len(k.rows[row]) {
+		k.fail(fmt.Errorf("row %d Has No Button %d, It Has %d Buttons", row, col, len(k.rows[row])))
+		return false
+	}
+
+	return true
+}
+
+func (k *InlineKeyboardBuilder) fail(err error) {
+	if k.err == nil {
+		k.err = err
+	}
+}
+
+// Markup : The Finished Keyboard, Or An Error If An Operation Failed, A Button Is Invalid
+// Or The Keyboard Is Over MaxInlineButtons Or MaxButtonsPerRow
+// Empty Rows Are Left Out
+func (k *InlineKeyboardBuilder) Markup() (InlineKeyboardMarkup, error) {
+	if k.err != nil {
+		return InlineKeyboardMarkup{}, k.err
+	}
+
+	rows := make([][]InlineKeyboard, 0, len(k.rows))
+	total := 0
+
+	for i, row := range k.rows {
+		if len(row) == 0 {
+			continue
+		}
+
+		if len(row) > MaxButtonsPerRow {
+			return InlineKeyboardMarkup{}, fmt.Errorf("row %d Has %d Buttons, The Limit Is %d", i, len(row), MaxButtonsPerRow)
+		}
+
+		for _, button := range row {
+			if err := button.Validate(); err != nil {
+				return InlineKeyboardMarkup{}, err
+			}
+		}
+
+		total += len(row)
+		rows = append(rows, append([]InlineKeyboard(nil), row...))
+	}
+
+	if total > MaxInlineButtons {
+		return InlineKeyboardMarkup{}, fmt.Errorf("keyboard Has %d Buttons, The Limit Is %d", total, MaxInlineButtons)
+	}
+
+	return InlineKeyboardMarkup{InlineKeyboard: rows}, nil
+}
+
+// MarshalJSON : Encode The Layout As An InlineKeyboardMarkup, Without Validating It
+func (k *InlineKeyboardBuilder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(InlineKeyboardMarkup{InlineKeyboard: k.rows})
+}
+
+// UnmarshalJSON : Replace The Layout With One Encoded As An InlineKeyboardMarkup
+func (k *InlineKeyboardBuilder) UnmarshalJSON(data []byte) error {
+	var markup InlineKeyboardMarkup
+
+	if err := json.Unmarshal(data, &markup); err != nil {
+		return err
+	}
+
+	k.rows, k.err = markup.InlineKeyboard, nil
+
+	return nil
+}
+
+// ReplyKeyboardBuilder : Builds A ReplyKeyboardMarkup For A Single Message
+// Like InlineKeyboardBuilder, It Encodes To And From The Same JSON As The Markup It Builds
+type ReplyKeyboardBuilder struct {
+	options ReplyKeyboardOptions
+	rows    [][]KeyboardButton
+}
+
+// NewReplyKeyboard : Start An Empty Reply Keyboard Shown According To options
+func NewReplyKeyboard(options ReplyKeyboardOptions) *ReplyKeyboardBuilder {
+	return &ReplyKeyboardBuilder{options: options}
+}
+
+// Row : Add A New Row Made Up Of buttons
+func (k *ReplyKeyboardBuilder) Row(buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	k.rows = append(k.rows, append([]KeyboardButton(nil), buttons...))
+	return k
+}
+
+// Button : Add button To The End Of The Last Row, Starting One If There Are None
+func (k *ReplyKeyboardBuilder) Button(button KeyboardButton) *ReplyKeyboardBuilder {
+	if len(k.rows) == 0 {
+		return k.Row(button)
+	}
+
+	last := len(k.rows) - 1
+	k.rows[last] = append(k.rows[last], button)
+
+	return k
+}
+
+// Markup : The Finished Keyboard
+func (k *ReplyKeyboardBuilder) Markup() ReplyKeyboardMarkup {
+	rows := make([][]KeyboardButton, 0, len(k.rows))
+
+	for _, row := range k.rows {
+		rows = append(rows, append([]KeyboardButton(nil), row...))
+	}
+
+	return ReplyKeyboardMarkup{
+		Keyboard:              rows,
+		IsPersistent:          k.options.IsPersistent,
+		ResizeKeyboard:        k.options.ResizeKeyboard,
+		OneTimeKeyboard:       k.options.OneTimeKeyboard,
+		InputFieldPlaceholder: k.options.InputFieldPlaceholder,
+		Selective:             k.options.Selective,
+	}
+}
+
+// MarshalJSON : Encode The Layout And Options As A ReplyKeyboardMarkup
+func (k *ReplyKeyboardBuilder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.Markup())
+}
+
+// UnmarshalJSON : Replace The Layout And Options With Ones Encoded As A ReplyKeyboardMarkup
+func (k *ReplyKeyboardBuilder) UnmarshalJSON(data []byte) error {
+	var markup ReplyKeyboardMarkup
+
+	if err := json.Unmarshal(data, &markup); err != nil {
+		return err
+	}
+
+	k.rows = markup.Keyboard
+	k.options = ReplyKeyboardOptions{
+		IsPersistent:          markup.IsPersistent,
+		ResizeKeyboard:        markup.ResizeKeyboard,
+		OneTimeKeyboard:       markup.OneTimeKeyboard,
+		InputFieldPlaceholder: markup.InputFieldPlaceholder,
+		Selective:             markup.Selective,
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package goTelegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestInlineKeyboardBuilder(t *testing.T) {
+	button := func(n int) InlineKeyboard {
+		return CallbackButton(fmt.Sprint(n), fmt.Sprint(n))
+	}
+
+	buttons := func(n int) []InlineKeyboard {
+		list := make([]InlineKeyboard, n)
+
+		for i := range list {
+			list[i] = button(i)
+		}
+
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		build   func() *InlineKeyboardBuilder
+		want    [][]InlineKeyboard
+		wantErr bool
+	}{
+		{
+			name: "rows and buttons",
+			build: func() *InlineKeyboardBuilder {
+				return NewInlineKeyboard().Button(button(1)).Button(button(2)).Row(button(3))
+			},
+			want: [][]InlineKeyboard{{button(1), button(2)}, {button(3)}},
+		},
+		{
+			name:  "grid",
+			build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Grid(2, buttons(5)...) },
+			want:  [][]InlineKeyboard{{button(0), button(1)}, {button(2), button(3)}, {button(4)}},
+		},
+		{name: "grid without columns", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Grid(0, button(1)) }, wantErr: true},
+		{
+			name: "insert, remove and replace",
+			build: func() *InlineKeyboardBuilder {
+				return NewInlineKeyboard().Row(button(1), button(3)).Insert(0, 1, button(2)).Remove(0, 0).Replace(0, 1, button(4))
+			},
+			want: [][]InlineKeyboard{{button(2), button(4)}},
+		},
+		{
+			name:  "insert at the end of a row",
+			build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Insert(0, 1, button(2)) },
+			want:  [][]InlineKeyboard{{button(1), button(2)}},
+		},
+		{
+			name:  "removing the last button drops the row",
+			build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Row(button(2)).Remove(0, 0) },
+			want:  [][]InlineKeyboard{{button(2)}},
+		},
+		{name: "insert into a missing row", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Insert(1, 0, button(2)) }, wantErr: true},
+		{name: "insert past the end of a row", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Insert(0, 2, button(2)) }, wantErr: true},
+		{name: "insert at a negative column", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Insert(0, -1, button(2)) }, wantErr: true},
+		{name: "remove a missing button", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Remove(0, 1) }, wantErr: true},
+		{name: "remove from a negative row", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Remove(-1, 0) }, wantErr: true},
+		{name: "replace a missing button", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(button(1)).Replace(0, 1, button(2)) }, wantErr: true},
+		{name: "append to a missing row", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Append(0, button(1)) }, wantErr: true},
+		{
+			name:    "error is kept after later operations succeed",
+			build:   func() *InlineKeyboardBuilder { return NewInlineKeyboard().Remove(0, 0).Row(button(1)) },
+			wantErr: true,
+		},
+		{name: "invalid button", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(InlineKeyboard{Text: "no action"}) }, wantErr: true},
+		{
+			name:  "row at MaxButtonsPerRow",
+			build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(buttons(MaxButtonsPerRow)...) },
+			want:  [][]InlineKeyboard{buttons(MaxButtonsPerRow)},
+		},
+		{name: "row over MaxButtonsPerRow", build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row(buttons(MaxButtonsPerRow + 1)...) }, wantErr: true},
+		{
+			name: "keyboard at MaxInlineButtons",
+			build: func() *InlineKeyboardBuilder {
+				return NewInlineKeyboard().Grid(MaxButtonsPerRow, buttons(MaxInlineButtons)...)
+			},
+			want: arrangeButtons(buttons(MaxInlineButtons), MaxButtonsPerRow),
+		},
+		{
+			name: "keyboard over MaxInlineButtons",
+			build: func() *InlineKeyboardBuilder {
+				return NewInlineKeyboard().Grid(MaxButtonsPerRow, buttons(MaxInlineButtons+1)...)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "empty rows are left out",
+			build: func() *InlineKeyboardBuilder { return NewInlineKeyboard().Row().Row(button(1)) },
+			want:  [][]InlineKeyboard{{button(1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markup, err := tt.build().Markup()
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Markup error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(markup.InlineKeyboard, tt.want) {
+				t.Errorf("Markup = %v, want %v", markup.InlineKeyboard, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyboardBuilderJSON(t *testing.T) {
+	inline := NewInlineKeyboard().Row(CallbackButton("a", "1"), URLButton("b", "https://example.com")).Row(PayButton("c"))
+	reply := NewReplyKeyboard(ReplyKeyboardOptions{ResizeKeyboard: true, InputFieldPlaceholder: "pick"}).
+		Row(KeyboardButton{Text: "a"}, KeyboardButton{Text: "b", RequestContact: true}).
+		Row(KeyboardButton{Text: "c"})
+
+	inlineMarkup, err := inline.Markup()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		builder interface{}
+		decoded interface{}
+		markup  func(decoded interface{}) (interface{}, error)
+		want    interface{}
+	}{
+		{
+			name:    "inline",
+			builder: inline,
+			decoded: NewInlineKeyboard(),
+			markup: func(decoded interface{}) (interface{}, error) {
+				return decoded.(*InlineKeyboardBuilder).Markup()
+			},
+			want: inlineMarkup,
+		},
+		{
+			name:    "reply",
+			builder: reply,
+			decoded: NewReplyKeyboard(ReplyKeyboardOptions{}),
+			markup: func(decoded interface{}) (interface{}, error) {
+				return decoded.(*ReplyKeyboardBuilder).Markup(), nil
+			},
+			want: reply.Markup(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.builder)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal(data, tt.decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.markup(tt.decoded)
+
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decoded %s to %+v, %v, want %+v", data, got, err, tt.want)
+			}
+
+			// The Builder Encodes As The Markup It Builds, So Stored Layouts Can Be Sent As Is
+			markupData, err := json.Marshal(tt.want)
+
+			if err != nil || string(markupData) != string(data) {
+				t.Errorf("builder encoded as %s, markup as %s", data, markupData)
+			}
+		})
+	}
+}
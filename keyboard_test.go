@@ -0,0 +1,184 @@
+package goTelegram
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestKeyboardManagerConcurrentUse(t *testing.T) {
+	k := newKeyboardManager()
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+
+		go func(worker int) {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				chatID := i % 4
+
+				switch (worker + i) % 7 {
+				case 0:
+					k.CreateKeyboard(chatID, 2)
+				case 1:
+					k.AddButton(chatID, fmt.Sprint(i), fmt.Sprint(worker))
+				case 2:
+					k.ClearKeyboard(chatID)
+				case 3:
+					k.DeleteKeyboard(chatID)
+				case 4:
+					if rows := k.ReturnKeyboard(chatID); len(rows) > 0 {
+						rows[0][0].Text = "changed by caller"
+					}
+				case 5:
+					_ = k.Markup(chatID)
+				case 6:
+					_ = k.HasKeyboard(chatID)
+				}
+			}
+		}(worker)
+	}
+
+	wg.Wait()
+}
+
+func TestReturnKeyboard(t *testing.T) {
+	button := func(n int) InlineKeyboard {
+		return InlineKeyboard{Text: fmt.Sprint(n), Data: fmt.Sprint(n)}
+	}
+
+	tests := []struct {
+		name  string
+		setup func(k *keyboardManager)
+		want  [][]InlineKeyboard
+	}{
+		{name: "unknown chat", setup: func(*keyboardManager) {}, want: nil},
+		{name: "deleted chat", setup: func(k *keyboardManager) { k.CreateKeyboard(1, 2); k.DeleteKeyboard(1) }, want: nil},
+		{name: "no buttons", setup: func(k *keyboardManager) { k.CreateKeyboard(1, 2) }, want: [][]InlineKeyboard{}},
+		{
+			name: "rows of max columns",
+			setup: func(k *keyboardManager) {
+				k.CreateKeyboard(1, 2)
+
+				for i := 1; i <= 3; i++ {
+					k.AddButton(1, fmt.Sprint(i), fmt.Sprint(i))
+				}
+			},
+			want: [][]InlineKeyboard{{button(1), button(2)}, {button(3)}},
+		},
+		{
+			name: "cleared",
+			setup: func(k *keyboardManager) {
+				k.CreateKeyboard(1, 2)
+				k.AddButton(1, "1", "1")
+				k.ClearKeyboard(1)
+			},
+			want: [][]InlineKeyboard{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := newKeyboardManager()
+			tt.setup(k)
+
+			if got := k.ReturnKeyboard(1); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReturnKeyboard = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReturnKeyboardIsACopy(t *testing.T) {
+	k := newKeyboardManager()
+	k.CreateKeyboard(1, 2)
+	k.AddButton(1, "a", "a")
+
+	rows := k.ReturnKeyboard(1)
+	rows[0][0].Text = "changed"
+
+	k.AddButton(1, "b", "b")
+
+	want := [][]InlineKeyboard{{{Text: "a", Data: "a"}, {Text: "b", Data: "b"}}}
+
+	if got := k.ReturnKeyboard(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("ReturnKeyboard = %#v, want %#v", got, want)
+	}
+}
+
+func TestKeyboardManagerMarkup(t *testing.T) {
+	remove := ReplyKeyboardRemove{}
+
+	tests := []struct {
+		name  string
+		setup func(k *keyboardManager)
+		want  ReplyMarkup
+	}{
+		{name: "none", setup: func(*keyboardManager) {}, want: nil},
+		{name: "empty inline keyboard", setup: func(k *keyboardManager) { k.CreateKeyboard(1, 3) }, want: nil},
+		{
+			name:  "inline keyboard",
+			setup: func(k *keyboardManager) { k.CreateKeyboard(1, 3); k.AddButton(1, "a", "b") },
+			want:  InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboard{{{Text: "a", Data: "b"}}}},
+		},
+		{
+			name: "reply keyboard",
+			setup: func(k *keyboardManager) {
+				k.CreateReplyKeyboard(1, 1, ReplyKeyboardOptions{ResizeKeyboard: true})
+				k.AddReplyButton(1, KeyboardButton{Text: "a"})
+				k.AddReplyButton(1, KeyboardButton{Text: "b"})
+			},
+			want: ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "a"}}, {{Text: "b"}}}, ResizeKeyboard: true},
+		},
+		{
+			name: "reply keyboard replaces inline keyboard",
+			setup: func(k *keyboardManager) {
+				k.CreateKeyboard(1, 3)
+				k.AddButton(1, "a", "b")
+				k.CreateReplyKeyboard(1, 2, ReplyKeyboardOptions{})
+				k.AddReplyButton(1, KeyboardButton{Text: "c"})
+			},
+			want: ReplyKeyboardMarkup{Keyboard: [][]KeyboardButton{{{Text: "c"}}}},
+		},
+		{
+			name: "inline keyboard replaces reply keyboard",
+			setup: func(k *keyboardManager) {
+				k.CreateReplyKeyboard(1, 2, ReplyKeyboardOptions{})
+				k.AddReplyButton(1, KeyboardButton{Text: "c"})
+				k.CreateKeyboard(1, 3)
+				k.AddButton(1, "a", "b")
+			},
+			want: InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboard{{{Text: "a", Data: "b"}}}},
+		},
+		{
+			name:  "same kind again keeps the buttons",
+			setup: func(k *keyboardManager) { k.CreateKeyboard(1, 3); k.AddButton(1, "a", "b"); k.CreateKeyboard(1, 1) },
+			want:  InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboard{{{Text: "a", Data: "b"}}}},
+		},
+		{
+			name:  "set markup wins",
+			setup: func(k *keyboardManager) { k.CreateKeyboard(1, 3); k.AddButton(1, "a", "b"); k.SetMarkup(1, remove) },
+			want:  remove,
+		},
+		{
+			name:  "cleared",
+			setup: func(k *keyboardManager) { k.SetMarkup(1, remove); k.ClearKeyboard(1) },
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := newKeyboardManager()
+			tt.setup(k)
+
+			if got := k.Markup(1); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Markup = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
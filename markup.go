@@ -0,0 +1,162 @@
+package goTelegram
+
+import "encoding/json"
+
+// ReplyMarkup : A Keyboard Or Reply Interface That Can Be Attached To A Message
+// Implemented By InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove And ForceReply
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+// InlineKeyboardMarkup : Buttons Shown Directly Below A Message
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboard `json:"inline_keyboard"`
+}
+
+// ReplyKeyboardMarkup : A Custom Keyboard Shown In Place Of The User's Keyboard
+type ReplyKeyboardMarkup struct {
+	Keyboard              [][]KeyboardButton `json:"keyboard"`
+	IsPersistent          bool               `json:"is_persistent,omitempty"`
+	ResizeKeyboard        bool               `json:"resize_keyboard,omitempty"`
+	OneTimeKeyboard       bool               `json:"one_time_keyboard,omitempty"`
+	InputFieldPlaceholder string             `json:"input_field_placeholder,omitempty"`
+	Selective             bool               `json:"selective,omitempty"`
+}
+
+// ReplyKeyboardOptions : How A Reply Keyboard Built By The Keyboard Manager Is Shown
+type ReplyKeyboardOptions struct {
+	// IsPersistent : Keep The Keyboard Shown When The User Hides Their Regular Keyboard
+	IsPersistent bool
+	// ResizeKeyboard : Shrink The Keyboard To Fit Its Buttons
+	ResizeKeyboard bool
+	// OneTimeKeyboard : Hide The Keyboard As Soon As A Button Is Pressed
+	OneTimeKeyboard bool
+	// InputFieldPlaceholder : Shown In The Input Field While The Keyboard Is Active, Up To 64 Characters
+	InputFieldPlaceholder string
+	// Selective : Only Show The Keyboard To Users Mentioned In Or Replied To By The Message
+	Selective bool
+}
+
+// KeyboardButton : A Button On A Reply Keyboard
+// Pressing It Sends Text As A Message, Unless One Of The Request Fields Is Set
+type KeyboardButton struct {
+	Text            string                      `json:"text"`
+	RequestUsers    *KeyboardButtonRequestUsers `json:"request_users,omitempty"`
+	RequestChat     *KeyboardButtonRequestChat  `json:"request_chat,omitempty"`
+	RequestContact  bool                        `json:"request_contact,omitempty"`
+	RequestLocation bool                        `json:"request_location,omitempty"`
+	RequestPoll     *KeyboardButtonPollType     `json:"request_poll,omitempty"`
+	WebApp          *WebAppInfo                 `json:"web_app,omitempty"`
+}
+
+// KeyboardButtonRequestUsers : Ask The User To Pick Users, Which Arrive As Message.UsersShared
+type KeyboardButtonRequestUsers struct {
+	RequestID       int   `json:"request_id"`
+	UserIsBot       *bool `json:"user_is_bot,omitempty"`
+	UserIsPremium   *bool `json:"user_is_premium,omitempty"`
+	MaxQuantity     int   `json:"max_quantity,omitempty"`
+	RequestName     bool  `json:"request_name,omitempty"`
+	RequestUsername bool  `json:"request_username,omitempty"`
+	RequestPhoto    bool  `json:"request_photo,omitempty"`
+}
+
+// KeyboardButtonRequestChat : Ask The User To Pick A Chat, Which Arrives As Message.ChatShared
+type KeyboardButtonRequestChat struct {
+	RequestID               int                      `json:"request_id"`
+	ChatIsChannel           bool                     `json:"chat_is_channel"`
+	ChatIsForum             *bool                    `json:"chat_is_forum,omitempty"`
+	ChatHasUsername         *bool                    `json:"chat_has_username,omitempty"`
+	ChatIsCreated           bool                     `json:"chat_is_created,omitempty"`
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	BotAdministratorRights  *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	BotIsMember             bool                     `json:"bot_is_member,omitempty"`
+	RequestTitle            bool                     `json:"request_title,omitempty"`
+	RequestUsername         bool                     `json:"request_username,omitempty"`
+	RequestPhoto            bool                     `json:"request_photo,omitempty"`
+}
+
+// ChatAdministratorRights : Rights An Administrator Has In A Chat
+type ChatAdministratorRights struct {
+	IsAnonymous         bool `json:"is_anonymous"`
+	CanManageChat       bool `json:"can_manage_chat"`
+	CanDeleteMessages   bool `json:"can_delete_messages"`
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	CanRestrictMembers  bool `json:"can_restrict_members"`
+	CanPromoteMembers   bool `json:"can_promote_members"`
+	CanChangeInfo       bool `json:"can_change_info"`
+	CanInviteUsers      bool `json:"can_invite_users"`
+	CanPostStories      bool `json:"can_post_stories"`
+	CanEditStories      bool `json:"can_edit_stories"`
+	CanDeleteStories    bool `json:"can_delete_stories"`
+	CanPostMessages     bool `json:"can_post_messages,omitempty"`
+	CanEditMessages     bool `json:"can_edit_messages,omitempty"`
+	CanPinMessages      bool `json:"can_pin_messages,omitempty"`
+	CanManageTopics     bool `json:"can_manage_topics,omitempty"`
+}
+
+// KeyboardButtonPollType : Ask The User To Create A Poll, Type Is "quiz", "regular" Or Empty For Either
+type KeyboardButtonPollType struct {
+	Type string `json:"type,omitempty"`
+}
+
+// WebAppInfo : A Web App Opened By A Button
+type WebAppInfo struct {
+	URL string `json:"url"`
+}
+
+// ReplyKeyboardRemove : Hide The Current Reply Keyboard
+type ReplyKeyboardRemove struct {
+	Selective bool
+}
+
+// MarshalJSON : Always Sends remove_keyboard As true, Which Telegram Requires
+func (r ReplyKeyboardRemove) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RemoveKeyboard bool `json:"remove_keyboard"`
+		Selective      bool `json:"selective,omitempty"`
+	}{true, r.Selective})
+}
+
+// ForceReply : Show The Reply Interface As If The User Had Chosen To Reply To The Message
+type ForceReply struct {
+	InputFieldPlaceholder string
+	Selective             bool
+}
+
+// MarshalJSON : Always Sends force_reply As true, Which Telegram Requires
+func (f ForceReply) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ForceReply            bool   `json:"force_reply"`
+		InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+		Selective             bool   `json:"selective,omitempty"`
+	}{true, f.InputFieldPlaceholder, f.Selective})
+}
+
+func (InlineKeyboardMarkup) replyMarkup() {}
+func (ReplyKeyboardMarkup) replyMarkup()  {}
+func (ReplyKeyboardRemove) replyMarkup()  {}
+func (ForceReply) replyMarkup()           {}
+
+// UsersShared : Users Picked With A KeyboardButtonRequestUsers Button
+type UsersShared struct {
+	RequestID int          `json:"request_id"`
+	Users     []SharedUser `json:"users"`
+}
+
+// SharedUser : A User Picked With A KeyboardButtonRequestUsers Button
+type SharedUser struct {
+	UserID    int         `json:"user_id"`
+	FirstName string      `json:"first_name,omitempty"`
+	LastName  string      `json:"last_name,omitempty"`
+	Username  string      `json:"username,omitempty"`
+	Photo     []PhotoSize `json:"photo,omitempty"`
+}
+
+// ChatShared : A Chat Picked With A KeyboardButtonRequestChat Button
+type ChatShared struct {
+	RequestID int         `json:"request_id"`
+	ChatID    int         `json:"chat_id"`
+	Title     string      `json:"title,omitempty"`
+	Username  string      `json:"username,omitempty"`
+	Photo     []PhotoSize `json:"photo,omitempty"`
+}
@@ -0,0 +1,175 @@
+package goTelegram
+
+// MessageEntity : A Special Part Of A Message's Text, e.g A Hashtag, Link Or Bold Text
+// Offset And Length Are Measured In UTF-16 Code Units
+type MessageEntity struct {
+	Type          string `json:"type"`
+	Offset        int    `json:"offset"`
+	Length        int    `json:"length"`
+	URL           string `json:"url,omitempty"`
+	User          *User  `json:"user,omitempty"`
+	Language      string `json:"language,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// MessageOrigin : Where A Forwarded Message Originally Came From
+// Type Is "user", "hidden_user", "chat" Or "channel"
+type MessageOrigin struct {
+	Type            string `json:"type"`
+	Date            int    `json:"date"`
+	SenderUser      *User  `json:"sender_user,omitempty"`
+	SenderUserName  string `json:"sender_user_name,omitempty"`
+	SenderChat      *Chat  `json:"sender_chat,omitempty"`
+	Chat            *Chat  `json:"chat,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// ExternalReply : A Message Being Replied To That Lives In Another Chat Or Forum Topic
+type ExternalReply struct {
+	Origin          MessageOrigin `json:"origin"`
+	Chat            *Chat         `json:"chat,omitempty"`
+	MessageID       int           `json:"message_id,omitempty"`
+	Animation       *Animation    `json:"animation,omitempty"`
+	Audio           *Audio        `json:"audio,omitempty"`
+	Document        *Document     `json:"document,omitempty"`
+	Photo           []PhotoSize   `json:"photo,omitempty"`
+	Sticker         *Sticker      `json:"sticker,omitempty"`
+	Video           *Video        `json:"video,omitempty"`
+	VideoNote       *VideoNote    `json:"video_note,omitempty"`
+	Voice           *Voice        `json:"voice,omitempty"`
+	HasMediaSpoiler bool          `json:"has_media_spoiler,omitempty"`
+	Contact         *Contact      `json:"contact,omitempty"`
+	Dice            *Dice         `json:"dice,omitempty"`
+	Location        *Location     `json:"location,omitempty"`
+	Poll            *Poll         `json:"poll,omitempty"`
+	Venue           *Venue        `json:"venue,omitempty"`
+}
+
+// TextQuote : The Part Of A Message Quoted In A Reply
+type TextQuote struct {
+	Text     string          `json:"text"`
+	Entities []MessageEntity `json:"entities,omitempty"`
+	Position int             `json:"position"`
+	IsManual bool            `json:"is_manual,omitempty"`
+}
+
+// PhotoSize : One Size Of A Photo Or Thumbnail
+type PhotoSize struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	FileSize     int    `json:"file_size,omitempty"`
+}
+
+// Document : A General File
+type Document struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+// Video : A Video File
+type Video struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	Duration     int        `json:"duration"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+// Animation : A GIF Or Soundless H.264/MPEG-4 AVC Video
+type Animation struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	Duration     int        `json:"duration"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+// Audio : A Music File
+type Audio struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Duration     int        `json:"duration"`
+	Performer    string     `json:"performer,omitempty"`
+	Title        string     `json:"title,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// Voice : A Voice Note
+type Voice struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Duration     int    `json:"duration"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int    `json:"file_size,omitempty"`
+}
+
+// VideoNote : A Round Video Message
+type VideoNote struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Length       int        `json:"length"`
+	Duration     int        `json:"duration"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+}
+
+// Sticker : A Sticker, Type Is "regular", "mask" Or "custom_emoji"
+type Sticker struct {
+	FileID          string     `json:"file_id"`
+	FileUniqueID    string     `json:"file_unique_id"`
+	Type            string     `json:"type"`
+	Width           int        `json:"width"`
+	Height          int        `json:"height"`
+	IsAnimated      bool       `json:"is_animated"`
+	IsVideo         bool       `json:"is_video"`
+	Thumbnail       *PhotoSize `json:"thumbnail,omitempty"`
+	Emoji           string     `json:"emoji,omitempty"`
+	SetName         string     `json:"set_name,omitempty"`
+	CustomEmojiID   string     `json:"custom_emoji_id,omitempty"`
+	NeedsRepainting bool       `json:"needs_repainting,omitempty"`
+	FileSize        int        `json:"file_size,omitempty"`
+}
+
+// Contact : A Phone Contact
+type Contact struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+	UserID      int    `json:"user_id,omitempty"`
+	VCard       string `json:"vcard,omitempty"`
+}
+
+// Dice : An Animated Emoji That Shows A Random Value
+type Dice struct {
+	Emoji string `json:"emoji"`
+	Value int    `json:"value"`
+}
+
+// Venue : A Place On The Map
+type Venue struct {
+	Location        Location `json:"location"`
+	Title           string   `json:"title"`
+	Address         string   `json:"address"`
+	FoursquareID    string   `json:"foursquare_id,omitempty"`
+	FoursquareType  string   `json:"foursquare_type,omitempty"`
+	GooglePlaceID   string   `json:"google_place_id,omitempty"`
+	GooglePlaceType string   `json:"google_place_type,omitempty"`
+}
@@ -0,0 +1,198 @@
+package goTelegram
+
+import (
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Handler : Function Called With An Incoming Update
+type Handler func(Update)
+
+// Middleware : Wraps A Handler With Extra Behaviour, e.g Logging Or Access Control
+type Middleware func(Handler) Handler
+
+// Use : Wrap Every Handler, Routed Or Set With SetHandler, With The Given Middlewares
+// The First Middleware Passed Is The Outermost One
+func (b *Bot) Use(middlewares ...Middleware) {
+	b.middlewares = append(b.middlewares, middlewares...)
+}
+
+func (b *Bot) wrap(fn Handler) Handler {
+	for i := len(b.middlewares) - 1; i >= 0; i-- {
+		fn = b.middlewares[i](fn)
+	}
+
+	return fn
+}
+
+// Recover : Stop A Panicking Handler From Crashing The Bot
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(update Update) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("Recovered From Panic While Handling Update %d: %v\n%s", update.UpdateID, r, debug.Stack())
+				}
+			}()
+
+			next(update)
+		}
+	}
+}
+
+// Logger : Log Every Update Along With How Long It Took To Handle
+func Logger() Middleware {
+	return func(next Handler) Handler {
+		return func(update Update) {
+			start := time.Now()
+
+			next(update)
+
+			log.Printf("Handled Update %d (type=%s command=%s user=%d) In %s", update.UpdateID, update.Type, update.Command, updateSender(update), time.Since(start))
+		}
+	}
+}
+
+// AllowUsers : Drop Updates From Anyone Not In userIDs
+// Updates Without A Sending User, Such As Channel Posts, Polls And Anonymous Reaction Counts, Are Dropped Too
+func AllowUsers(userIDs ...int) Middleware {
+	allowed := make(map[int]bool, len(userIDs))
+
+	for _, id := range userIDs {
+		allowed[id] = true
+	}
+
+	return func(next Handler) Handler {
+		return func(update Update) {
+			if !allowed[updateSender(update)] {
+				return
+			}
+
+			next(update)
+		}
+	}
+}
+
+// Throttle : Drop Updates From A User Arriving Less Than interval After Their Previous One
+func Throttle(interval time.Duration) Middleware {
+	var mu sync.Mutex
+
+	lastSeen := make(map[int]time.Time)
+
+	return func(next Handler) Handler {
+		return func(update Update) {
+			sender := updateSender(update)
+
+			if sender != 0 {
+				now := time.Now()
+
+				mu.Lock()
+
+				last, seen := lastSeen[sender]
+
+				if seen && now.Sub(last) < interval {
+					mu.Unlock()
+					return
+				}
+
+				lastSeen[sender] = now
+
+				if len(lastSeen) > 1024 {
+					for id, t := range lastSeen {
+						if now.Sub(t) >= interval {
+							delete(lastSeen, id)
+						}
+					}
+				}
+
+				mu.Unlock()
+			}
+
+			next(update)
+		}
+	}
+}
+
+// updateSender : ID Of The User Who Triggered update, Or 0 When There Isn't One
+func updateSender(update Update) int {
+	switch {
+	case update.CallbackQuery.From.ID != 0:
+		return update.CallbackQuery.From.ID
+	case update.EditedMessage.From.ID != 0:
+		return update.EditedMessage.From.ID
+	case update.Message.From.ID != 0:
+		return update.Message.From.ID
+	case update.ChannelPost != nil:
+		return update.ChannelPost.From.ID
+	case update.EditedChannelPost != nil:
+		return update.EditedChannelPost.From.ID
+	case update.BusinessConnection != nil:
+		return update.BusinessConnection.User.ID
+	case update.BusinessMessage != nil:
+		return update.BusinessMessage.From.ID
+	case update.EditedBusinessMessage != nil:
+		return update.EditedBusinessMessage.From.ID
+	case update.MessageReaction != nil && update.MessageReaction.User != nil:
+		return update.MessageReaction.User.ID
+	case update.InlineQuery != nil:
+		return update.InlineQuery.From.ID
+	case update.ChosenInlineResult != nil:
+		return update.ChosenInlineResult.From.ID
+	case update.ShippingQuery != nil:
+		return update.ShippingQuery.From.ID
+	case update.PreCheckoutQuery != nil:
+		return update.PreCheckoutQuery.From.ID
+	case update.PurchasedPaidMedia != nil:
+		return update.PurchasedPaidMedia.From.ID
+	case update.PollAnswer != nil && update.PollAnswer.User != nil:
+		return update.PollAnswer.User.ID
+	case update.MyChatMember != nil:
+		return update.MyChatMember.From.ID
+	case update.ChatMember != nil:
+		return update.ChatMember.From.ID
+	case update.ChatJoinRequest != nil:
+		return update.ChatJoinRequest.From.ID
+	default:
+		return 0
+	}
+}
+
+// updateChat : ID Of The Chat update Belongs To, Or 0 When There Isn't One
+func updateChat(update Update) int {
+	switch {
+	case update.CallbackQuery.Message.Chat.ID != 0:
+		return update.CallbackQuery.Message.Chat.ID
+	case update.EditedMessage.Chat.ID != 0:
+		return update.EditedMessage.Chat.ID
+	case update.Message.Chat.ID != 0:
+		return update.Message.Chat.ID
+	case update.ChannelPost != nil:
+		return update.ChannelPost.Chat.ID
+	case update.EditedChannelPost != nil:
+		return update.EditedChannelPost.Chat.ID
+	case update.BusinessMessage != nil:
+		return update.BusinessMessage.Chat.ID
+	case update.EditedBusinessMessage != nil:
+		return update.EditedBusinessMessage.Chat.ID
+	case update.DeletedBusinessMessages != nil:
+		return update.DeletedBusinessMessages.Chat.ID
+	case update.MessageReaction != nil:
+		return update.MessageReaction.Chat.ID
+	case update.MessageReactionCount != nil:
+		return update.MessageReactionCount.Chat.ID
+	case update.MyChatMember != nil:
+		return update.MyChatMember.Chat.ID
+	case update.ChatMember != nil:
+		return update.ChatMember.Chat.ID
+	case update.ChatJoinRequest != nil:
+		return update.ChatJoinRequest.Chat.ID
+	case update.ChatBoost != nil:
+		return update.ChatBoost.Chat.ID
+	case update.RemovedChatBoost != nil:
+		return update.RemovedChatBoost.Chat.ID
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,154 @@
+package goTelegram
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// captureLog : Collect Everything Logged While The Test Runs
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	return &buf
+}
+
+func TestUseOrdering(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+
+	var calls []string
+
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(update Update) {
+				calls = append(calls, name+" in")
+				next(update)
+				calls = append(calls, name+" out")
+			}
+		}
+	}
+
+	bot.Use(trace("a"), trace("b"))
+	bot.Use(trace("c"))
+	bot.SetHandler(func(Update) { calls = append(calls, "handler") })
+
+	bot.handleUpdate(Update{UpdateID: 1})
+
+	want := []string{"a in", "b in", "c in", "handler", "c out", "b out", "a out"}
+
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	logged := captureLog(t)
+	after := false
+
+	handler := Recover()(func(Update) { panic("boom") })
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("panic escaped Recover: %v", r)
+			}
+		}()
+
+		handler(Update{UpdateID: 4})
+		after = true
+	}()
+
+	if !after {
+		t.Error("Recover didn't return normally")
+	}
+
+	if out := logged.String(); !strings.Contains(out, "Update 4: boom") || !strings.Contains(out, "goroutine") {
+		t.Errorf("log didn't record the panic and stack: %q", out)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	logged := captureLog(t)
+	called := false
+
+	Logger()(func(Update) { called = true })(Update{UpdateID: 7, Type: UpdateText, Command: "/start", Message: Message{From: User{ID: 42}}})
+
+	if !called {
+		t.Fatal("Logger didn't call the next handler")
+	}
+
+	if out := logged.String(); !strings.Contains(out, "Handled Update 7 (type=text command=/start user=42)") {
+		t.Errorf("log = %q", out)
+	}
+}
+
+func TestAllowUsers(t *testing.T) {
+	tests := []struct {
+		name   string
+		update Update
+		want   bool
+	}{
+		{name: "allowed message", update: Update{Message: Message{From: User{ID: 1}}}, want: true},
+		{name: "allowed callback", update: Update{CallbackQuery: CallbackQuery{ID: "q", From: User{ID: 2}}}, want: true},
+		{name: "other user", update: Update{Message: Message{From: User{ID: 3}}}},
+		{name: "channel post", update: Update{ChannelPost: &Message{Chat: Chat{ID: -100}}}},
+		{name: "poll", update: Update{Poll: &Poll{ID: "p"}}},
+		{name: "empty update", update: Update{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+
+			AllowUsers(1, 2)(func(Update) { called = true })(tt.update)
+
+			if called != tt.want {
+				t.Errorf("handler called = %v, want %v", called, tt.want)
+			}
+		})
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	from := func(id int) Update { return Update{Message: Message{From: User{ID: id}}} }
+
+	tests := []struct {
+		name    string
+		updates []Update
+		pause   time.Duration
+		want    int
+	}{
+		{name: "same user twice", updates: []Update{from(1), from(1)}, want: 1},
+		{name: "different users", updates: []Update{from(1), from(2), from(3)}, want: 3},
+		{name: "same user after the interval", updates: []Update{from(1), from(1)}, pause: 60 * time.Millisecond, want: 2},
+		{name: "updates without a sender", updates: []Update{{}, {}, {}}, want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			handler := Throttle(50 * time.Millisecond)(func(Update) { calls++ })
+
+			for i, update := range tt.updates {
+				if i > 0 {
+					time.Sleep(tt.pause)
+				}
+
+				handler(update)
+			}
+
+			if calls != tt.want {
+				t.Errorf("handler called %d times, want %d", calls, tt.want)
+			}
+		})
+	}
+}
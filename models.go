@@ -1,32 +1,75 @@
 package goTelegram
 
+import "net/http"
+
 // Bot : Main Bot Struct
 type Bot struct {
-	Me              user `json:"result"`
+	Me              User `json:"result"`
 	APIURL          string
-	handler         func(Update)
+	handler         Handler
 	handlerSet      bool
 	keyboardManager *keyboardManager
-}
-
-type user struct {
-	ID        int    `json:"id"`
-	Firstname string `json:"first_name"`
-	Username  string `json:"username"`
+	webhook         *webhookGuard
+	client          *http.Client
+	baseURL         string
+	token           string
+	localMode       bool
+	limiter         *rateLimiter
+	router          *router
+	middlewares     []Middleware
+	dispatcher      *dispatcher
+	requests        *requestTracker
+	callbacks       *callbackCodec
+}
+
+// User : A Telegram User Or Bot
+type User struct {
+	ID                      int    `json:"id"`
+	IsBot                   bool   `json:"is_bot"`
+	Firstname               string `json:"first_name"`
+	LastName                string `json:"last_name,omitempty"`
+	Username                string `json:"username,omitempty"`
+	LanguageCode            string `json:"language_code,omitempty"`
+	IsPremium               bool   `json:"is_premium,omitempty"`
+	AddedToAttachmentMenu   bool   `json:"added_to_attachment_menu,omitempty"`
+	CanJoinGroups           bool   `json:"can_join_groups,omitempty"`
+	CanReadAllGroupMessages bool   `json:"can_read_all_group_messages,omitempty"`
+	SupportsInlineQueries   bool   `json:"supports_inline_queries,omitempty"`
+	CanConnectToBusiness    bool   `json:"can_connect_to_business,omitempty"`
+	HasMainWebApp           bool   `json:"has_main_web_app,omitempty"`
 }
 
 // Update : Stores Data From Request
+// Type Tells Which Of The Fields Is Set, Optional Kinds Are nil When Absent
 type Update struct {
-	UpdateID      int           `json:"update_id"`
-	EditedMessage Message       `json:"edited_message"`
-	Message       Message       `json:"message"`
-	CallbackQuery callbackQuery `json:"callback_query"`
-	Command       string
-	Type          string
-}
-
-type result struct {
-	File fileDets `json:"result"`
+	UpdateID                int                          `json:"update_id"`
+	EditedMessage           Message                      `json:"edited_message"`
+	Message                 Message                      `json:"message"`
+	CallbackQuery           CallbackQuery                `json:"callback_query"`
+	ChannelPost             *Message                     `json:"channel_post,omitempty"`
+	EditedChannelPost       *Message                     `json:"edited_channel_post,omitempty"`
+	BusinessConnection      *BusinessConnection          `json:"business_connection,omitempty"`
+	BusinessMessage         *Message                     `json:"business_message,omitempty"`
+	EditedBusinessMessage   *Message                     `json:"edited_business_message,omitempty"`
+	DeletedBusinessMessages *BusinessMessagesDeleted     `json:"deleted_business_messages,omitempty"`
+	MessageReaction         *MessageReactionUpdated      `json:"message_reaction,omitempty"`
+	MessageReactionCount    *MessageReactionCountUpdated `json:"message_reaction_count,omitempty"`
+	InlineQuery             *InlineQuery                 `json:"inline_query,omitempty"`
+	ChosenInlineResult      *ChosenInlineResult          `json:"chosen_inline_result,omitempty"`
+	ShippingQuery           *ShippingQuery               `json:"shipping_query,omitempty"`
+	PreCheckoutQuery        *PreCheckoutQuery            `json:"pre_checkout_query,omitempty"`
+	PurchasedPaidMedia      *PaidMediaPurchased          `json:"purchased_paid_media,omitempty"`
+	Poll                    *Poll                        `json:"poll,omitempty"`
+	PollAnswer              *PollAnswer                  `json:"poll_answer,omitempty"`
+	MyChatMember            *ChatMemberUpdated           `json:"my_chat_member,omitempty"`
+	ChatMember              *ChatMemberUpdated           `json:"chat_member,omitempty"`
+	ChatJoinRequest         *ChatJoinRequest             `json:"chat_join_request,omitempty"`
+	ChatBoost               *ChatBoostUpdated            `json:"chat_boost,omitempty"`
+	RemovedChatBoost        *ChatBoostRemoved            `json:"removed_chat_boost,omitempty"`
+	Command                 string
+	// Args : Words Following The Command, e.g ["a", "b"] For "/start a b"
+	Args []string
+	Type UpdateType
 }
 
 type fileDets struct {
@@ -36,32 +79,66 @@ type fileDets struct {
 	FilePath     string `json:"file_path"`
 }
 
+// Message : A Message In A Chat
+// Only The Fields Matching The Kind Of Message Are Filled In
 type Message struct {
-	MessageID int         `json:"message_id"`
-	Text      string      `json:"Text"`
-	Chat      Chat        `json:"chat"`
-	From      user        `json:"from"`
-	File      document    `json:"document"`
-	Photo     []photoSize `json:"photo"`
-	Video     video       `json:"video"`
-}
-
-type document struct {
-	FileID       string `json:"file_id"`
-	FileUniqueID string `json:"file_unique_id"`
-	FileName     string `json:"file_name"`
-	FileSize     int    `json:"file_size"`
-}
-
-type photoSize struct {
-	document
-	Width  int `json:"width"`
-	Height int `json:"height"`
-}
-
-type video struct {
-	photoSize
-	Duration int `json:"duration"`
+	MessageID             int                   `json:"message_id"`
+	MessageThreadID       int                   `json:"message_thread_id,omitempty"`
+	From                  User                  `json:"from"`
+	SenderChat            *Chat                 `json:"sender_chat,omitempty"`
+	SenderBoostCount      int                   `json:"sender_boost_count,omitempty"`
+	SenderBusinessBot     *User                 `json:"sender_business_bot,omitempty"`
+	Date                  int                   `json:"date"`
+	BusinessConnectionID  string                `json:"business_connection_id,omitempty"`
+	Chat                  Chat                  `json:"chat"`
+	ForwardOrigin         *MessageOrigin        `json:"forward_origin,omitempty"`
+	IsTopicMessage        bool                  `json:"is_topic_message,omitempty"`
+	IsAutomaticForward    bool                  `json:"is_automatic_forward,omitempty"`
+	ReplyToMessage        *Message              `json:"reply_to_message,omitempty"`
+	ExternalReply         *ExternalReply        `json:"external_reply,omitempty"`
+	Quote                 *TextQuote            `json:"quote,omitempty"`
+	ViaBot                *User                 `json:"via_bot,omitempty"`
+	EditDate              int                   `json:"edit_date,omitempty"`
+	HasProtectedContent   bool                  `json:"has_protected_content,omitempty"`
+	IsFromOffline         bool                  `json:"is_from_offline,omitempty"`
+	MediaGroupID          string                `json:"media_group_id,omitempty"`
+	AuthorSignature       string                `json:"author_signature,omitempty"`
+	Text                  string                `json:"text"`
+	Entities              []MessageEntity       `json:"entities,omitempty"`
+	LinkPreviewOptions    *LinkPreviewOptions   `json:"link_preview_options,omitempty"`
+	EffectID              string                `json:"effect_id,omitempty"`
+	Animation             *Animation            `json:"animation,omitempty"`
+	Audio                 *Audio                `json:"audio,omitempty"`
+	File                  Document              `json:"document"`
+	Photo                 []PhotoSize           `json:"photo"`
+	Sticker               *Sticker              `json:"sticker,omitempty"`
+	Video                 Video                 `json:"video"`
+	VideoNote             *VideoNote            `json:"video_note,omitempty"`
+	Voice                 *Voice                `json:"voice,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	CaptionEntities       []MessageEntity       `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	HasMediaSpoiler       bool                  `json:"has_media_spoiler,omitempty"`
+	Contact               *Contact              `json:"contact,omitempty"`
+	Dice                  *Dice                 `json:"dice,omitempty"`
+	Poll                  *Poll                 `json:"poll,omitempty"`
+	Venue                 *Venue                `json:"venue,omitempty"`
+	Location              *Location             `json:"location,omitempty"`
+	NewChatMembers        []User                `json:"new_chat_members,omitempty"`
+	LeftChatMember        *User                 `json:"left_chat_member,omitempty"`
+	NewChatTitle          string                `json:"new_chat_title,omitempty"`
+	NewChatPhoto          []PhotoSize           `json:"new_chat_photo,omitempty"`
+	DeleteChatPhoto       bool                  `json:"delete_chat_photo,omitempty"`
+	GroupChatCreated      bool                  `json:"group_chat_created,omitempty"`
+	SupergroupChatCreated bool                  `json:"supergroup_chat_created,omitempty"`
+	ChannelChatCreated    bool                  `json:"channel_chat_created,omitempty"`
+	MigrateToChatID       int                   `json:"migrate_to_chat_id,omitempty"`
+	MigrateFromChatID     int                   `json:"migrate_from_chat_id,omitempty"`
+	PinnedMessage         *Message              `json:"pinned_message,omitempty"`
+	ConnectedWebsite      string                `json:"connected_website,omitempty"`
+	UsersShared           *UsersShared          `json:"users_shared,omitempty"`
+	ChatShared            *ChatShared           `json:"chat_shared,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
 type InputMedia struct {
@@ -76,45 +153,44 @@ type InputMedia struct {
 }
 
 type mediaGroup struct {
-	ChatID              string          `json:"chat_id"`
-	Media               []InputMedia    `json:"media"`
-	DisableNotification bool            `json:"disable_notification,omitempty"`
-	ProtectContent      bool            `json:"disable_content_type_detection,omitempty"`
-	ReplyParameters     replyParameters `json:"reply_to_message_id,omitempty"`
+	ChatID              string           `json:"chat_id"`
+	Media               []InputMedia     `json:"media"`
+	DisableNotification bool             `json:"disable_notification,omitempty"`
+	ProtectContent      bool             `json:"protect_content,omitempty"`
+	ReplyParameters     *ReplyParameters `json:"reply_parameters,omitempty"`
 }
 
 type MediaOptions struct {
 	UseSpoiler       bool
 	SendNotification bool `json:"disable_notification,omitempty"`
 	ProtectContent   bool `json:"disable_content_type_detection,omitempty"`
+	// ReplyMarkup : Keyboard Attached To The Message, Takes Priority Over The Chat's Keyboard
+	// Telegram Doesn't Allow One On A Media Group, So It Is Ignored There
+	ReplyMarkup ReplyMarkup `json:"-"`
 }
 
+// Chat : A Private Chat, Group, Supergroup Or Channel
 type Chat struct {
-	ID   int    `json:"id"`
-	Type string `json:"type"`
-}
-
-// InlineKeyboard : Structure To Hold The Keyboard To Be Sent
-type InlineKeyboard struct {
-	Text string `json:"text"`
-	Data string `json:"callback_data"`
-}
-
-type replyParameters struct {
-	MessageID                int    `json:"message_id"`
-	ChatID                   int    `json:"chat_id,omitempty"`
-	AllowSendingWithoutReply bool   `json:"allow_sending_without_reply,omitempty"`
-	Quote                    string `json:"quote,omitempty"`
-	QuoteParseMode           string `json:"quote_parse_mode,omitempty"`
-	QuotePosition            int    `json:"quote_position,omitempty"`
+	ID        int    `json:"id"`
+	Type      string `json:"type"`
+	Title     string `json:"title,omitempty"`
+	Username  string `json:"username,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	IsForum   bool   `json:"is_forum,omitempty"`
 }
 
 type replyBody struct {
-	ChatID          string          `json:"chat_id,omitempty"`
-	Text            string          `json:"text,omitempty"`
-	ParseMode       string          `json:"parse_mode,omitempty"`
-	ReplyMarkup     replyMarkup     `json:"reply_markup,omitempty"`
-	ReplyParameters replyParameters `json:"reply_to_message_id,omitempty"`
+	ChatID              string              `json:"chat_id,omitempty"`
+	MessageThreadID     int                 `json:"message_thread_id,omitempty"`
+	Text                string              `json:"text,omitempty"`
+	ParseMode           string              `json:"parse_mode,omitempty"`
+	Entities            []MessageEntity     `json:"entities,omitempty"`
+	LinkPreviewOptions  *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	DisableNotification bool                `json:"disable_notification,omitempty"`
+	ProtectContent      bool                `json:"protect_content,omitempty"`
+	ReplyParameters     *ReplyParameters    `json:"reply_parameters,omitempty"`
+	ReplyMarkup         ReplyMarkup         `json:"reply_markup,omitempty"`
 }
 
 type videoBody struct {
@@ -123,17 +199,16 @@ type videoBody struct {
 	Caption        string      `json:"caption,omitempty"`
 	HasSpoiler     bool        `json:"has_spoiler,omitempty"`
 	ProtectContent bool        `json:"protect_content,omitempty"`
+	ReplyMarkup    ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
-type replyMarkup struct {
-	InlineKeyboard [][]InlineKeyboard `json:"inline_keyboard,omitempty"`
-}
-
-type callbackQuery struct {
-	ID      string  `json:"id"`
-	From    user    `json:"from"`
-	Data    string  `json:"data"`
-	Message Message `json:"message"`
+type photoBody struct {
+	ChatID         string      `json:"chat_id"`
+	Photo          interface{} `json:"photo"`
+	Caption        string      `json:"caption,omitempty"`
+	HasSpoiler     bool        `json:"has_spoiler,omitempty"`
+	ProtectContent bool        `json:"protect_content,omitempty"`
+	ReplyMarkup    ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 type answerCallback struct {
@@ -143,10 +218,13 @@ type answerCallback struct {
 }
 
 type editBody struct {
-	MessageID   int         `json:"message_id"`
-	Text        string      `json:"text"`
-	ChatID      string      `json:"chat_id"`
-	ReplyMarkup replyMarkup `json:"reply_markup,omitempty"`
+	MessageID          int                 `json:"message_id"`
+	Text               string              `json:"text"`
+	ChatID             string              `json:"chat_id"`
+	ParseMode          string              `json:"parse_mode,omitempty"`
+	Entities           []MessageEntity     `json:"entities,omitempty"`
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	ReplyMarkup        ReplyMarkup         `json:"reply_markup,omitempty"`
 }
 
 type deleteBody struct {
@@ -155,17 +233,22 @@ type deleteBody struct {
 }
 
 type keyboard struct {
-	Buttons  []InlineKeyboard
-	Keyboard [][]InlineKeyboard
+	Buttons []InlineKeyboard
 }
 
+// TResponse : Envelope Of A Response To A Send Request
+//
+// Deprecated: Send Methods Return The Sent Message, And Call Decodes Any Other Result. TResponse Will Be Removed In The Next Release
 type TResponse struct {
 	Ok     bool   `json:"ok"`
 	Result Result `json:"result"`
 }
 
+// Result : The Parts Of A Sent Message Carried By TResponse
+//
+// Deprecated: Use Message, Which Send Methods Now Return. Result Will Be Removed In The Next Release
 type Result struct {
 	MessageId int  `json:"message_id"`
-	From      user `json:"from"`
+	From      User `json:"from"`
 	Chat      Chat `json:"chat"`
 }
@@ -0,0 +1,63 @@
+package goTelegram
+
+// Parse Modes Accepted By Telegram For Formatting Text
+const (
+	ParseModeMarkdownV2 = "MarkdownV2"
+	ParseModeHTML       = "HTML"
+	// ParseModeMarkdown : Legacy Markdown, Kept For Backward Compatibility
+	ParseModeMarkdown = "Markdown"
+)
+
+// MessageOptions : Optional Parameters For Sending, Replying To And Editing Text Messages
+// The Zero Value Sends A Plain Message With The Chat's Keyboard, If One Was Created
+type MessageOptions struct {
+	// ParseMode : One Of The ParseMode Constants, Leave Empty When Using Entities
+	ParseMode string
+	// Entities : Formatting Applied To The Text, Used Instead Of ParseMode
+	Entities []MessageEntity
+	// LinkPreviewOptions : How The Link Preview Is Generated, If At All
+	LinkPreviewOptions *LinkPreviewOptions
+	// DisableNotification : Deliver The Message Silently, Ignored When Editing
+	DisableNotification bool
+	// ProtectContent : Stop The Message From Being Forwarded Or Saved, Ignored When Editing
+	ProtectContent bool
+	// MessageThreadID : Forum Topic To Send The Message To, Ignored When Editing
+	MessageThreadID int
+	// ReplyParameters : Message To Reply To, Ignored When Editing
+	// ReplyMessageWithOptions Fills It In When Left Empty
+	ReplyParameters *ReplyParameters
+	// ReplyMarkup : Keyboard Attached To The Message, e.g Built With NewInlineKeyboard
+	// It Takes Priority Over Any Keyboard Set Up For The Chat With CreateKeyboard Or SetReplyMarkup
+	// Only An InlineKeyboardMarkup Can Be Attached When Editing
+	ReplyMarkup ReplyMarkup
+}
+
+// LinkPreviewOptions : Controls The Preview Shown For A Link In The Message
+type LinkPreviewOptions struct {
+	IsDisabled       bool   `json:"is_disabled,omitempty"`
+	URL              string `json:"url,omitempty"`
+	PreferSmallMedia bool   `json:"prefer_small_media,omitempty"`
+	PreferLargeMedia bool   `json:"prefer_large_media,omitempty"`
+	ShowAboveText    bool   `json:"show_above_text,omitempty"`
+}
+
+// ReplyParameters : The Message Being Replied To, Optionally Quoting Part Of It
+type ReplyParameters struct {
+	MessageID                int             `json:"message_id"`
+	ChatID                   int             `json:"chat_id,omitempty"`
+	AllowSendingWithoutReply bool            `json:"allow_sending_without_reply,omitempty"`
+	Quote                    string          `json:"quote,omitempty"`
+	QuoteParseMode           string          `json:"quote_parse_mode,omitempty"`
+	QuoteEntities            []MessageEntity `json:"quote_entities,omitempty"`
+	QuotePosition            int             `json:"quote_position,omitempty"`
+}
+
+// markupFor : The Markup To Attach To A Message Sent To chatID
+// An Explicit Markup Wins, Otherwise The Chat's Keyboard Or Markup Is Used If It Has One
+func (b *Bot) markupFor(chatID int, markup ReplyMarkup) ReplyMarkup {
+	if markup != nil {
+		return markup
+	}
+
+	return b.keyboardManager.Markup(chatID)
+}
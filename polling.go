@@ -0,0 +1,180 @@
+package goTelegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// PollingOptions : Settings Used By StartPolling When Fetching Updates
+type PollingOptions struct {
+	// Timeout : Long Polling Timeout In Seconds, Defaults To 30
+	// BotOptions.Client's Timeout, If Set, Must Be Longer Than This Or StartPolling Returns An Error
+	Timeout int
+	// Limit : Maximum Number Of Updates Fetched Per Request (1-100), Defaults To 100
+	Limit int
+	// AllowedUpdates : Update Kinds To Receive, Leave Empty To Keep Telegram's Current Setting
+	AllowedUpdates []string
+	// MinBackoff : Delay Before Retrying A Failed Request, Defaults To One Second
+	MinBackoff time.Duration
+	// MaxBackoff : Upper Bound For The Retry Delay, Defaults To One Minute
+	MaxBackoff time.Duration
+}
+
+// pollMargin : Time Allowed On Top Of The Long Polling Timeout For Telegram To Answer
+const pollMargin = 10 * time.Second
+
+type getUpdatesBody struct {
+	Offset         int      `json:"offset,omitempty"`
+	Limit          int      `json:"limit,omitempty"`
+	Timeout        int      `json:"timeout"`
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+// StartPolling : Fetch Updates Using getUpdates Instead Of A Webhook
+// It Blocks Until ctx Is Cancelled Or Shutdown Is Called, Passing Every Update To The Function Set With SetHandler
+// Each Request Is Abandoned And Retried If Telegram Hasn't Answered Within The Polling Timeout Plus A Margin
+func (b *Bot) StartPolling(ctx context.Context, options ...PollingOptions) error {
+	if !b.hasHandler() {
+		return errors.New("no Handler Set, Please Set A Function To Be Called Upon New Updates")
+	}
+
+	if b.dispatcher == nil {
+		b.dispatcher = newDispatcher(DispatchOptions{})
+	}
+
+	if b.dispatcher.isClosed() {
+		return ErrBotShutdown
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-b.dispatcher.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var opts PollingOptions
+
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if opts.Timeout <= 0 {
+		opts.Timeout = 30
+	}
+
+	pollTimeout := time.Duration(opts.Timeout) * time.Second
+
+	if client := b.httpClient(); client.Timeout > 0 && client.Timeout <= pollTimeout {
+		return fmt.Errorf("http Client Timeout Of %s Is Shorter Than The Polling Timeout Of %s, Raise It Or Lower PollingOptions.Timeout", client.Timeout, pollTimeout)
+	}
+
+	if opts.Limit <= 0 || opts.Limit > 100 {
+		opts.Limit = 100
+	}
+
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = time.Second
+	}
+
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = time.Minute
+	}
+
+	offset := 0
+	backoff := opts.MinBackoff
+
+	for ctx.Err() == nil {
+		pollCtx, cancelPoll := context.WithTimeout(ctx, pollTimeout+pollMargin)
+
+		updates, err := b.getUpdates(pollCtx, getUpdatesBody{
+			Offset:         offset,
+			Limit:          opts.Limit,
+			Timeout:        opts.Timeout,
+			AllowedUpdates: opts.AllowedUpdates,
+		})
+
+		cancelPoll()
+
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+
+			log.Println("Couldn't Fetch Updates, Retrying In", backoff)
+			log.Println(err)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+
+			if backoff > opts.MaxBackoff {
+				backoff = opts.MaxBackoff
+			}
+
+			continue
+		}
+
+		backoff = opts.MinBackoff
+
+		for _, update := range updates {
+			if update.UpdateID < offset {
+				continue
+			}
+
+			b.classifyUpdate(&update)
+			b.resolveCallback(ctx, &update)
+
+			if b.dispatch(ctx, update) != nil {
+				break
+			}
+
+			offset = update.UpdateID + 1
+		}
+	}
+
+	if offset != 0 {
+		b.acknowledgeUpdates(offset)
+	}
+
+	return nil
+}
+
+// acknowledgeUpdates : Confirm Every Update Before offset So Telegram Doesn't Send Them Again
+func (b *Bot) acknowledgeUpdates(offset int) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := b.getUpdates(ctx, getUpdatesBody{
+		Offset:  offset,
+		Limit:   1,
+		Timeout: 0,
+	})
+
+	if err != nil {
+		log.Println("Couldn't Acknowledge Processed Updates")
+		log.Println(err)
+	}
+}
+
+func (b *Bot) getUpdates(ctx context.Context, body getUpdatesBody) ([]Update, error) {
+	var updates []Update
+
+	err := b.Call(ctx, "getUpdates", body, &updates)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,202 @@
+package goTelegram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStartPolling(t *testing.T) {
+	api := newFakeAPI(t)
+
+	api.handle("getUpdates", func(body []byte) (int, string) {
+		var req getUpdatesBody
+
+		_ = json.Unmarshal(body, &req)
+
+		if req.Offset == 0 {
+			return http.StatusOK, `{"ok":true,"result":[
+				{"update_id":10,"message":{"message_id":1,"chat":{"id":5},"from":{"id":5},"text":"/start@testbot now"}},
+				{"update_id":11,"callback_query":{"id":"q","data":"pick","from":{"id":5},"message":{"message_id":2,"chat":{"id":5}}}}
+			]}`
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		return http.StatusOK, `{"ok":true,"result":[]}`
+	})
+
+	bot := newTestBot(t, api, BotOptions{})
+	received := make(chan Update, 2)
+
+	bot.SetHandler(func(update Update) { received <- update })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() { done <- bot.StartPolling(ctx, PollingOptions{Timeout: 1}) }()
+
+	got := map[int]Update{}
+
+	for len(got) < 2 {
+		select {
+		case update := <-received:
+			got[update.UpdateID] = update
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for updates")
+		}
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("StartPolling returned %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("StartPolling didn't stop after ctx was cancelled")
+	}
+
+	if u := got[10]; u.Type != UpdateText || u.Command != "/start" || len(u.Args) != 1 || u.Args[0] != "now" {
+		t.Errorf("update 10 classified as type=%q command=%q args=%v", u.Type, u.Command, u.Args)
+	}
+
+	if u := got[11]; u.Type != UpdateCallback || u.CallbackQuery.Data != "pick" {
+		t.Errorf("update 11 classified as type=%q data=%q", u.Type, u.CallbackQuery.Data)
+	}
+
+	requests := api.requests("getUpdates")
+
+	if len(requests) < 3 {
+		t.Fatalf("expected a fetch, a follow-up and an acknowledgement, got %d requests", len(requests))
+	}
+
+	for _, body := range requests[1:] {
+		var req getUpdatesBody
+
+		_ = json.Unmarshal(body, &req)
+
+		if req.Offset != 12 {
+			t.Errorf("request after the first batch used offset %d, want 12", req.Offset)
+		}
+	}
+}
+
+func TestStartPollingBacksOffOnFailure(t *testing.T) {
+	api := newFakeAPI(t)
+
+	api.handle("getUpdates", func([]byte) (int, string) {
+		return http.StatusBadGateway, "bad gateway"
+	})
+
+	bot := newTestBot(t, api, BotOptions{})
+	bot.SetHandler(func(Update) {})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+
+	err := bot.StartPolling(ctx, PollingOptions{MinBackoff: 20 * time.Millisecond, MaxBackoff: 40 * time.Millisecond})
+
+	if err != nil {
+		t.Fatalf("StartPolling returned %v", err)
+	}
+
+	// Retries Are Spaced 20ms, Then 40ms Apart, So 250ms Allows Roughly 7 Attempts, Not Hundreds
+	if n := len(api.requests("getUpdates")); n < 2 || n > 12 {
+		t.Errorf("made %d requests in 250ms, backoff isn't being applied", n)
+	}
+}
+
+func TestStartPollingWithoutHandler(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+
+	if err := bot.StartPolling(context.Background()); err == nil {
+		t.Fatal("StartPolling without a handler should fail")
+	}
+}
+
+func TestClassifyUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		update      string
+		wantType    UpdateType
+		wantCommand string
+		wantArgs    []string
+	}{
+		{name: "text", update: `{"message":{"message_id":1,"text":"hello there"}}`, wantType: UpdateText},
+		{name: "command", update: `{"message":{"message_id":1,"text":"/start a b"}}`, wantType: UpdateText, wantCommand: "/start", wantArgs: []string{"a", "b"}},
+		{name: "command addressed to the bot", update: `{"message":{"message_id":1,"text":"/help@testbot"}}`, wantType: UpdateText, wantCommand: "/help", wantArgs: []string{}},
+		{name: "command addressed to another bot", update: `{"message":{"message_id":1,"text":"/help@otherbot"}}`, wantType: UpdateText, wantCommand: "/help@otherbot", wantArgs: []string{}},
+		{name: "edited text", update: `{"edited_message":{"message_id":1,"text":"/fix it"}}`, wantType: UpdateEditedText, wantCommand: "/fix", wantArgs: []string{"it"}},
+		{name: "callback", update: `{"callback_query":{"id":"q","data":"x"}}`, wantType: UpdateCallback},
+		{name: "document", update: `{"message":{"message_id":1,"document":{"file_id":"f","file_name":"a.txt"}}}`, wantType: UpdateDocument},
+		{name: "photo", update: `{"message":{"message_id":1,"photo":[{"file_id":"p"}]}}`, wantType: UpdatePhoto},
+		{name: "video", update: `{"message":{"message_id":1,"video":{"file_id":"v"}}}`, wantType: UpdateVideo},
+		{name: "other message", update: `{"message":{"message_id":1,"dice":{"emoji":"🎲","value":3}}}`, wantType: UpdateMessage},
+		{name: "channel post", update: `{"channel_post":{"message_id":1,"chat":{"id":-100}}}`, wantType: UpdateChannelPost},
+		{name: "inline query", update: `{"inline_query":{"id":"i","query":"q"}}`, wantType: UpdateInlineQuery},
+		{name: "unknown", update: `{"update_id":1}`, wantType: UpdateUnknown},
+	}
+
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var update Update
+
+			if err := json.Unmarshal([]byte(tt.update), &update); err != nil {
+				t.Fatal(err)
+			}
+
+			bot.classifyUpdate(&update)
+
+			if update.Type != tt.wantType || update.Command != tt.wantCommand {
+				t.Errorf("classified as type=%q command=%q, want type=%q command=%q", update.Type, update.Command, tt.wantType, tt.wantCommand)
+			}
+
+			if tt.wantArgs != nil && !reflect.DeepEqual(update.Args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", update.Args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestStartPollingRejectsShortClientTimeout(t *testing.T) {
+	tests := []struct {
+		name          string
+		clientTimeout time.Duration
+		pollTimeout   int
+		wantErr       bool
+	}{
+		{name: "shorter than the poll", clientTimeout: 5 * time.Second, pollTimeout: 30, wantErr: true},
+		{name: "equal to the poll", clientTimeout: 30 * time.Second, pollTimeout: 30, wantErr: true},
+		{name: "shorter than the default poll", clientTimeout: 10 * time.Second, wantErr: true},
+		{name: "longer than the poll", clientTimeout: 2 * time.Second, pollTimeout: 1},
+		{name: "no client timeout", pollTimeout: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			bot := newTestBot(t, api, BotOptions{Client: &http.Client{Timeout: tt.clientTimeout}})
+			bot.SetHandler(func(Update) {})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err := bot.StartPolling(ctx, PollingOptions{Timeout: tt.pollTimeout})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StartPolling returned %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if polled := len(api.requests("getUpdates")) > 0; polled == tt.wantErr {
+				t.Errorf("getUpdates called = %v, want %v", polled, !tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package goTelegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitOptions : Limits Applied To Outgoing Messages
+// Zero Values Fall Back To Telegram's Documented Limits
+type RateLimitOptions struct {
+	// Disabled : Send Requests Immediately And Don't Retry When Telegram Returns 429
+	Disabled bool
+	// GlobalPerSecond : Messages Sent Per Second Across All Chats, Defaults To 30
+	GlobalPerSecond float64
+	// PrivateChatPerSecond : Messages Sent Per Second To A Single Private Chat, Defaults To 1
+	PrivateChatPerSecond float64
+	// GroupPerMinute : Messages Sent Per Minute To A Single Group Or Channel, Defaults To 20
+	GroupPerMinute float64
+	// MaxRetries : Times A Request Is Retried After A 429, Defaults To 3, Set To -1 To Never Retry
+	MaxRetries int
+	// Methods : Bot API Methods Spaced Out By The Limits Above, Defaults To Those That Send A Message,
+	// See IsSendMethod. Other Requests, Such As deleteMessage Or banChatMember, Go Out Immediately
+	Methods []string
+}
+
+// IsSendMethod : Report Whether method Sends A Message, Which Is What Telegram's Rate Limits Count
+// That Is Every send* Method Apart From sendChatAction, Plus copyMessage(s) And forwardMessage(s)
+func IsSendMethod(method string) bool {
+	switch method {
+	case "sendChatAction":
+		return false
+	case "copyMessage", "copyMessages", "forwardMessage", "forwardMessages":
+		return true
+	default:
+		return strings.HasPrefix(method, "send")
+	}
+}
+
+// RateLimitStats : Snapshot Of The Outbound Rate Limiter
+type RateLimitStats struct {
+	// Waiting : Requests Currently Queued For A Free Slot
+	Waiting int
+	// WaitingByChat : Queued Requests Per Chat ID
+	WaitingByChat map[string]int
+	// Retries : Requests Retried After Telegram Returned 429 Since The Bot Was Created
+	Retries int
+}
+
+type rateLimiter struct {
+	mu            sync.Mutex
+	global        time.Duration
+	private       time.Duration
+	group         time.Duration
+	maxRetries    int
+	methods       map[string]bool
+	nextGlobal    time.Time
+	nextChat      map[string]time.Time
+	waitingByChat map[string]int
+	waiting       int
+	retries       int
+}
+
+func newRateLimiter(options RateLimitOptions) *rateLimiter {
+	if options.Disabled {
+		return nil
+	}
+
+	if options.GlobalPerSecond <= 0 {
+		options.GlobalPerSecond = 30
+	}
+
+	if options.PrivateChatPerSecond <= 0 {
+		options.PrivateChatPerSecond = 1
+	}
+
+	if options.GroupPerMinute <= 0 {
+		options.GroupPerMinute = 20
+	}
+
+	if options.MaxRetries == 0 {
+		options.MaxRetries = 3
+	}
+
+	var methods map[string]bool
+
+	if len(options.Methods) > 0 {
+		methods = make(map[string]bool, len(options.Methods))
+
+		for _, method := range options.Methods {
+			methods[method] = true
+		}
+	}
+
+	return &rateLimiter{
+		methods:       methods,
+		global:        time.Duration(float64(time.Second) / options.GlobalPerSecond),
+		private:       time.Duration(float64(time.Second) / options.PrivateChatPerSecond),
+		group:         time.Duration(float64(time.Minute) / options.GroupPerMinute),
+		maxRetries:    options.MaxRetries,
+		nextChat:      make(map[string]time.Time),
+		waitingByChat: make(map[string]int),
+	}
+}
+
+// RateLimitStats : Report How Many Requests Are Waiting On The Rate Limiter
+func (b *Bot) RateLimitStats() RateLimitStats {
+	l := b.limiter
+
+	if l == nil {
+		return RateLimitStats{WaitingByChat: map[string]int{}}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats := RateLimitStats{
+		Waiting:       l.waiting,
+		WaitingByChat: make(map[string]int, len(l.waitingByChat)),
+		Retries:       l.retries,
+	}
+
+	for chatID, waiting := range l.waitingByChat {
+		stats.WaitingByChat[chatID] = waiting
+	}
+
+	return stats
+}
+
+// limits : Report Whether Requests To method Wait On The Limiter
+func (l *rateLimiter) limits(method string) bool {
+	if l == nil {
+		return false
+	}
+
+	if l.methods != nil {
+		return l.methods[method]
+	}
+
+	return IsSendMethod(method)
+}
+
+// wait : Block Until A Request To chatID May Be Sent
+// If ctx Ends First, The Slot Is Given Back So Later Requests Aren't Held Up By It
+func (l *rateLimiter) wait(ctx context.Context, chatID string) error {
+	if l == nil || chatID == "" {
+		return nil
+	}
+
+	l.mu.Lock()
+
+	now := time.Now()
+	at := now
+
+	prevChat, prevGlobal := l.nextChat[chatID], l.nextGlobal
+
+	if prevChat.After(at) {
+		at = prevChat
+	}
+
+	if prevGlobal.After(at) {
+		at = prevGlobal
+	}
+
+	l.nextGlobal = at.Add(l.global)
+	l.nextChat[chatID] = at.Add(l.chatInterval(chatID))
+
+	reservedChat, reservedGlobal := l.nextChat[chatID], l.nextGlobal
+
+	if len(l.nextChat) > 1024 {
+		for id, next := range l.nextChat {
+			if next.Before(now) {
+				delete(l.nextChat, id)
+			}
+		}
+	}
+
+	l.waiting++
+	l.waitingByChat[chatID]++
+
+	l.mu.Unlock()
+
+	err := sleep(ctx, time.Until(at))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.waiting--
+	l.waitingByChat[chatID]--
+
+	if l.waitingByChat[chatID] == 0 {
+		delete(l.waitingByChat, chatID)
+	}
+
+	// A Slot Can Only Be Given Back While No Later Request Has Been Scheduled After It
+	if err != nil {
+		if l.nextChat[chatID].Equal(reservedChat) {
+			l.nextChat[chatID] = prevChat
+		}
+
+		if l.nextGlobal.Equal(reservedGlobal) {
+			l.nextGlobal = prevGlobal
+		}
+	}
+
+	return err
+}
+
+// backOff : Record A 429 For chatID And Report How Long To Wait Before Retrying
+func (l *rateLimiter) backOff(err error, chatID string, attempt int) (time.Duration, bool) {
+	var apiErr *APIError
+
+	if l == nil || attempt >= l.maxRetries || !errors.As(err, &apiErr) || !IsRateLimited(err) {
+		return 0, false
+	}
+
+	delay := time.Duration(apiErr.Parameters.RetryAfter) * time.Second
+
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.retries++
+
+	if chatID != "" {
+		if until := time.Now().Add(delay); until.After(l.nextChat[chatID]) {
+			l.nextChat[chatID] = until
+		}
+	}
+
+	return delay, true
+}
+
+func (l *rateLimiter) chatInterval(chatID string) time.Duration {
+	if strings.HasPrefix(chatID, "-") || strings.HasPrefix(chatID, "@") {
+		return l.group
+	}
+
+	return l.private
+}
+
+// sleep : Wait For d Or Until ctx Is Done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// chatIDOf : Pull The chat_id Parameter Out Of An Encoded Request Body
+func chatIDOf(contentType string, body []byte) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return ""
+	}
+
+	switch mediaType {
+	case "application/json":
+		var target struct {
+			ChatID json.RawMessage `json:"chat_id"`
+		}
+
+		if json.Unmarshal(body, &target) != nil {
+			return ""
+		}
+
+		return strings.Trim(string(target.ChatID), `"`)
+
+	case "multipart/form-data":
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+
+		for {
+			part, err := reader.NextPart()
+
+			if err != nil {
+				return ""
+			}
+
+			if part.FormName() == "chat_id" {
+				value, _ := io.ReadAll(part)
+				return string(value)
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,209 @@
+package goTelegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryOnTooManyRequests(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   RateLimitOptions
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "retried after retry_after", options: RateLimitOptions{PrivateChatPerSecond: 100}, wantCalls: 2},
+		{name: "never retried with MaxRetries -1", options: RateLimitOptions{MaxRetries: -1}, wantCalls: 1, wantErr: true},
+		{name: "never retried when disabled", options: RateLimitOptions{Disabled: true}, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+
+			var calls atomic.Int32
+
+			api.handle("sendMessage", func([]byte) (int, string) {
+				if calls.Add(1) == 1 {
+					return http.StatusTooManyRequests, `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`
+				}
+
+				return http.StatusOK, `{"ok":true,"result":{"message_id":7,"chat":{"id":5}}}`
+			})
+
+			bot := newTestBot(t, api, BotOptions{RateLimit: tt.options})
+
+			start := time.Now()
+			message, err := bot.SendMessage("hi", Chat{ID: 5})
+
+			if got := int(calls.Load()); got != tt.wantCalls {
+				t.Errorf("sendMessage called %d times, want %d", got, tt.wantCalls)
+			}
+
+			if tt.wantErr {
+				if !IsRateLimited(err) {
+					t.Errorf("expected a rate limit error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil || message.MessageID != 7 {
+				t.Fatalf("SendMessage = %+v, %v", message, err)
+			}
+
+			if elapsed := time.Since(start); elapsed < time.Second {
+				t.Errorf("retried after %s, retry_after asked for 1s", elapsed)
+			}
+
+			if stats := bot.RateLimitStats(); stats.Retries != 1 {
+				t.Errorf("RateLimitStats().Retries = %d, want 1", stats.Retries)
+			}
+		})
+	}
+}
+
+func TestRateLimiterSpacing(t *testing.T) {
+	tests := []struct {
+		name    string
+		options RateLimitOptions
+		chats   []string
+		minimum time.Duration
+	}{
+		{name: "same private chat", options: RateLimitOptions{PrivateChatPerSecond: 20}, chats: []string{"5", "5", "5"}, minimum: 100 * time.Millisecond},
+		{name: "same group", options: RateLimitOptions{GroupPerMinute: 1200}, chats: []string{"-100", "-100", "-100"}, minimum: 100 * time.Millisecond},
+		{name: "channel username counts as a group", options: RateLimitOptions{GroupPerMinute: 1200}, chats: []string{"@news", "@news", "@news"}, minimum: 100 * time.Millisecond},
+		{name: "global limit across chats", options: RateLimitOptions{GlobalPerSecond: 20}, chats: []string{"1", "2", "3"}, minimum: 100 * time.Millisecond},
+		{name: "different chats under the global limit", options: RateLimitOptions{}, chats: []string{"1", "2", "3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newRateLimiter(tt.options)
+			start := time.Now()
+
+			for _, chatID := range tt.chats {
+				if err := limiter.wait(context.Background(), chatID); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			elapsed := time.Since(start)
+
+			if elapsed < tt.minimum {
+				t.Errorf("%d requests took %s, want at least %s", len(tt.chats), elapsed, tt.minimum)
+			}
+
+			if tt.minimum == 0 && elapsed > 200*time.Millisecond {
+				t.Errorf("%d requests took %s, expected no real wait", len(tt.chats), elapsed)
+			}
+		})
+	}
+}
+
+func TestRateLimiterWaitHonoursContext(t *testing.T) {
+	limiter := newRateLimiter(RateLimitOptions{GroupPerMinute: 600, GlobalPerSecond: 1000})
+	start := time.Now()
+
+	_ = limiter.wait(context.Background(), "-1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.wait(ctx, "-1"); err == nil {
+		t.Fatal("wait should give up once ctx is done")
+	}
+
+	if stats := (&Bot{limiter: limiter}).RateLimitStats(); stats.Waiting != 0 || len(stats.WaitingByChat) != 0 {
+		t.Errorf("abandoned wait still counted: %+v", stats)
+	}
+
+	// The Abandoned Wait Held The Slot 100ms Out, The Next Request Should Get It Back Rather Than Waiting 200ms
+	if err := limiter.wait(context.Background(), "-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 170*time.Millisecond {
+		t.Errorf("request after an abandoned wait was sent after %s, its slot wasn't given back", elapsed)
+	}
+}
+
+func TestRateLimiterMethods(t *testing.T) {
+	tests := []struct {
+		method  string
+		methods []string
+		want    bool
+	}{
+		{method: "sendMessage", want: true},
+		{method: "sendPhoto", want: true},
+		{method: "sendMediaGroup", want: true},
+		{method: "copyMessage", want: true},
+		{method: "copyMessages", want: true},
+		{method: "forwardMessage", want: true},
+		{method: "forwardMessages", want: true},
+		{method: "sendChatAction", want: false},
+		{method: "deleteMessage", want: false},
+		{method: "editMessageText", want: false},
+		{method: "banChatMember", want: false},
+		{method: "getChat", want: false},
+		{method: "editMessageText", methods: []string{"editMessageText"}, want: true},
+		{method: "sendMessage", methods: []string{"editMessageText"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.method, tt.methods), func(t *testing.T) {
+			if got := newRateLimiter(RateLimitOptions{Methods: tt.methods}).limits(tt.method); got != tt.want {
+				t.Errorf("limits(%q) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModerationIsNotRateLimited(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+	group := Chat{ID: -100}
+	start := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if err := bot.DeleteMessage(Message{MessageID: i, Chat: group}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("three deletions in one group took %s, they shouldn't wait on the per-chat limit", elapsed)
+	}
+}
+
+func TestChatIDOf(t *testing.T) {
+	contentType, body, err := encodeMultipart(map[string]interface{}{"chat_id": "-100", "caption": "x"}, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{name: "json number", contentType: "application/json", body: `{"chat_id":5,"text":"x"}`, want: "5"},
+		{name: "json string", contentType: "application/json; charset=utf-8", body: `{"chat_id":"@news"}`, want: "@news"},
+		{name: "json without chat", contentType: "application/json", body: `{"offset":3}`, want: ""},
+		{name: "multipart", contentType: contentType, body: string(body), want: "-100"},
+		{name: "unknown content type", contentType: "text/plain", body: "chat_id=5", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chatIDOf(tt.contentType, []byte(tt.body)); got != tt.want {
+				t.Errorf("chatIDOf = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
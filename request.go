@@ -0,0 +1,159 @@
+package goTelegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// BotOptions : Settings Used By NewBotWithOptions
+type BotOptions struct {
+	// Client : HTTP Client Used For Every Request, Defaults To http.DefaultClient
+	// When Polling, Its Timeout Must Be Longer Than PollingOptions.Timeout
+	Client *http.Client
+	// Transport : Used To Build A Client When Client Is Nil
+	Transport http.RoundTripper
+	// APIEndpoint : Base URL Of The Bot API Server, Defaults To https://api.telegram.org
+	// Set It To The Address Of A Self-Hosted telegram-bot-api Server, e.g http://localhost:8081
+	APIEndpoint string
+	// LocalMode : The Server At APIEndpoint Runs With --local, So Files Are Read Straight From The Absolute Paths
+	// It Returns. Only Enable It When That Server Is Trusted And Shares This Machine's Disk
+	LocalMode bool
+	// RateLimit : Outbound Rate Limiting, Enabled With Telegram's Default Limits Unless Disabled
+	RateLimit RateLimitOptions
+	// Dispatch : Worker Pool Settings For Running Handlers
+	Dispatch DispatchOptions
+}
+
+// DefaultAPIEndpoint : Base URL Of Telegram's Public Bot API Server
+const DefaultAPIEndpoint = "https://api.telegram.org"
+
+func (b *Bot) httpClient() *http.Client {
+	if b.client == nil {
+		return http.DefaultClient
+	}
+
+	return b.client
+}
+
+// do : Send A Request To The Bot API And Return The Body Of A Successful Response
+// Messages Sent To A Chat Wait On The Rate Limiter First, And Any Request Is Retried When Telegram Returns 429
+func (b *Bot) do(ctx context.Context, method, contentType string, body []byte) ([]byte, error) {
+	b.requests.begin()
+	defer b.requests.end()
+
+	chatID := ""
+
+	if b.limiter.limits(method) {
+		chatID = chatIDOf(contentType, body)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := b.limiter.wait(ctx, chatID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := b.send(ctx, method, contentType, body)
+
+		delay, retry := b.limiter.backOff(err, chatID, attempt)
+
+		if !retry {
+			return resp, err
+		}
+
+		log.Println("Too Many Requests, Retrying", method, "In", delay)
+
+		err = sleep(ctx, delay)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// send : Make A Single Request To The Bot API
+func (b *Bot) send(ctx context.Context, method, contentType string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.APIURL+"/"+method, bytes.NewReader(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := b.httpClient().Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp.StatusCode, respBody)
+	}
+
+	return respBody, nil
+}
+
+// doJSON : Marshal payload And Send It To The Bot API
+func (b *Bot) doJSON(ctx context.Context, method string, payload interface{}) ([]byte, error) {
+	jsonBody, err := json.Marshal(payload)
+
+	if err != nil {
+		log.Println("There Was An Error Marshalling The Object")
+		return nil, err
+	}
+
+	return b.do(ctx, method, "application/json", jsonBody)
+}
+
+// fileURL : Build The Download Link For A File Path Returned By getFile
+func (b *Bot) fileURL(filePath string) string {
+	baseURL, token := b.baseURL, b.token
+
+	if baseURL == "" {
+		if index := strings.LastIndex(b.APIURL, "/bot"); index >= 0 {
+			baseURL, token = b.APIURL[:index], b.APIURL[index+len("/bot"):]
+		}
+	}
+
+	return baseURL + "/file/bot" + token + "/" + strings.TrimPrefix(filePath, "/")
+}
+
+// get : Fetch A URL Using The Bot's HTTP Client
+func (b *Bot) get(ctx context.Context, link string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := b.httpClient().Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		return nil, newAPIError(resp.StatusCode, body)
+	}
+
+	return resp, nil
+}
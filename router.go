@@ -0,0 +1,199 @@
+package goTelegram
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+type router struct {
+	mu        sync.RWMutex
+	commands  map[string]Handler
+	texts     []textRoute
+	callbacks []callbackRoute
+	types     map[UpdateType]Handler
+	fallback  Handler
+	// edits : Route Edited Messages Through Commands And Text Patterns Too
+	edits bool
+}
+
+type textRoute struct {
+	pattern *regexp.Regexp
+	handler Handler
+}
+
+type callbackRoute struct {
+	prefix  string
+	handler Handler
+}
+
+func newRouter() *router {
+	return &router{
+		commands: make(map[string]Handler),
+		types:    make(map[UpdateType]Handler),
+	}
+}
+
+func (b *Bot) routes() *router {
+	if b.router == nil {
+		b.router = newRouter()
+	}
+
+	return b.router
+}
+
+// HandleCommand : Run fn For Messages Starting With command, e.g "/start"
+// The @botusername Suffix Is Stripped Before Matching And Anything After The Command Is Available In Update.Args
+// Only New Messages Are Matched Unless HandleEdits Is Turned On
+func (b *Bot) HandleCommand(command string, fn Handler) {
+	r := b.routes()
+
+	if !strings.HasPrefix(command, "/") {
+		command = "/" + command
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.commands[command] = fn
+}
+
+// HandleText : Run fn For Text Messages Matching pattern
+// Only New Messages Are Matched Unless HandleEdits Is Turned On
+func (b *Bot) HandleText(pattern *regexp.Regexp, fn Handler) {
+	r := b.routes()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.texts = append(r.texts, textRoute{pattern: pattern, handler: fn})
+}
+
+// HandleEdits : Also Match Edited Messages Against HandleCommand And HandleText Routes
+// It Is Off By Default, So Editing An Old Command Doesn't Run It A Second Time
+// Edited Messages Can Always Be Handled With HandleType(UpdateEditedText, ...)
+func (b *Bot) HandleEdits(enabled bool) {
+	r := b.routes()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.edits = enabled
+}
+
+// HandleCallback : Run fn For Callback Queries Whose Data Starts With prefix
+func (b *Bot) HandleCallback(prefix string, fn Handler) {
+	r := b.routes()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.callbacks = append(r.callbacks, callbackRoute{prefix: prefix, handler: fn})
+}
+
+// HandleType : Run fn For Updates Of The Given Type, e.g UpdatePhoto
+func (b *Bot) HandleType(updateType UpdateType, fn Handler) {
+	r := b.routes()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.types[updateType] = fn
+}
+
+// HandleFallback : Run fn For Updates No Other Route Matches
+// Without A Fallback, Unmatched Updates Go To The Function Set With SetHandler
+func (b *Bot) HandleFallback(fn Handler) {
+	r := b.routes()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallback = fn
+}
+
+// hasHandler : Report Whether Anything Will Receive Incoming Updates
+func (b *Bot) hasHandler() bool {
+	if b.handlerSet {
+		return true
+	}
+
+	r := b.router
+
+	if r == nil {
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.commands) > 0 || len(r.texts) > 0 || len(r.callbacks) > 0 || len(r.types) > 0 || r.fallback != nil
+}
+
+// handleUpdate : Pass update To The Route It Matches
+func (b *Bot) handleUpdate(update Update) {
+	fn := b.match(update)
+
+	if fn == nil {
+		return
+	}
+
+	b.wrap(fn)(update)
+}
+
+func (b *Bot) match(update Update) Handler {
+	r := b.router
+
+	if r == nil {
+		return b.handler
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	text := r.routedText(update)
+
+	if text != "" && update.Command != "" {
+		if fn, ok := r.commands[update.Command]; ok {
+			return fn
+		}
+	}
+
+	if update.CallbackQuery.ID != "" {
+		for _, route := range r.callbacks {
+			if strings.HasPrefix(update.CallbackQuery.Data, route.prefix) {
+				return route.handler
+			}
+		}
+	}
+
+	if text != "" {
+		for _, route := range r.texts {
+			if route.pattern.MatchString(text) {
+				return route.handler
+			}
+		}
+	}
+
+	if fn, ok := r.types[update.Type]; ok {
+		return fn
+	}
+
+	if r.fallback != nil {
+		return r.fallback
+	}
+
+	return b.handler
+}
+
+// routedText : The Text Matched Against Commands And Patterns, Empty For Updates They Don't Apply To
+func (r *router) routedText(update Update) string {
+	switch {
+	case update.Type == UpdateText:
+		return update.Message.Text
+	case update.Type == UpdateEditedText && r.edits:
+		return update.EditedMessage.Text
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,93 @@
+package goTelegram
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestRouterPrecedence(t *testing.T) {
+	tests := []struct {
+		name       string
+		update     string
+		edits      bool
+		noFallback bool
+		want       string
+		wantArgs   []string
+	}{
+		{name: "command", update: `{"message":{"message_id":1,"text":"/start now please"}}`, want: "command", wantArgs: []string{"now", "please"}},
+		{name: "command addressed to the bot", update: `{"message":{"message_id":1,"text":"/start@testbot x"}}`, want: "command", wantArgs: []string{"x"}},
+		{name: "command addressed to another bot", update: `{"message":{"message_id":1,"text":"/start@otherbot"}}`, want: "type"},
+		{name: "command beats text pattern", update: `{"message":{"message_id":1,"text":"/start hello"}}`, want: "command", wantArgs: []string{"hello"}},
+		{name: "unknown command falls to type", update: `{"message":{"message_id":1,"text":"/nope"}}`, want: "type"},
+		{name: "first text pattern wins", update: `{"message":{"message_id":1,"text":"hello there"}}`, want: "text"},
+		{name: "second text pattern", update: `{"message":{"message_id":1,"text":"goodbye"}}`, want: "text2"},
+		{name: "callback prefix", update: `{"callback_query":{"id":"q","data":"buy:1"}}`, want: "callback"},
+		{name: "unmatched callback", update: `{"callback_query":{"id":"q","data":"sell:1"}}`, want: "fallback"},
+		{name: "type", update: `{"message":{"message_id":1,"photo":[{"file_id":"p"}]}}`, want: "photo"},
+		{name: "edited command isn't routed", update: `{"edited_message":{"message_id":1,"text":"/start again"}}`, want: "fallback"},
+		{name: "edited text isn't routed", update: `{"edited_message":{"message_id":1,"text":"hello"}}`, want: "fallback"},
+		{name: "edited command with edits on", update: `{"edited_message":{"message_id":1,"text":"/start again"}}`, edits: true, want: "command", wantArgs: []string{"again"}},
+		{name: "edited text with edits on", update: `{"edited_message":{"message_id":1,"text":"hello"}}`, edits: true, want: "text"},
+		{name: "fallback", update: `{"channel_post":{"message_id":1,"chat":{"id":-100}}}`, want: "fallback"},
+		{name: "set handler without a fallback", update: `{"channel_post":{"message_id":1,"chat":{"id":-100}}}`, noFallback: true, want: "handler"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+
+			var got string
+			var args []string
+
+			route := func(name string) Handler {
+				return func(update Update) { got, args = name, update.Args }
+			}
+
+			bot.SetHandler(route("handler"))
+			bot.HandleCommand("start", route("command"))
+			bot.HandleText(regexp.MustCompile(`hello`), route("text"))
+			bot.HandleText(regexp.MustCompile(`^good`), route("text2"))
+			bot.HandleCallback("buy:", route("callback"))
+			bot.HandleType(UpdateText, route("type"))
+			bot.HandleType(UpdatePhoto, route("photo"))
+			bot.HandleEdits(tt.edits)
+
+			if !tt.noFallback {
+				bot.HandleFallback(route("fallback"))
+			}
+
+			var update Update
+
+			if err := json.Unmarshal([]byte(tt.update), &update); err != nil {
+				t.Fatal(err)
+			}
+
+			bot.classifyUpdate(&update)
+			bot.handleUpdate(update)
+
+			if got != tt.want {
+				t.Errorf("routed to %q, want %q", got, tt.want)
+			}
+
+			if tt.wantArgs != nil && !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestRouterWithoutRoutes(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+
+	if bot.hasHandler() {
+		t.Error("a bot with no routes reports a handler")
+	}
+
+	bot.HandleCommand("/start", func(Update) {})
+
+	if !bot.hasHandler() {
+		t.Error("a bot with a command route reports no handler")
+	}
+}
@@ -0,0 +1,103 @@
+package goTelegram
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ShutdownError : Work Still Outstanding When Shutdown's Context Ended
+type ShutdownError struct {
+	// QueuedUpdates : Updates Accepted But Never Handed To A Handler
+	QueuedUpdates int
+	// RunningHandlers : Handlers Still Running
+	RunningHandlers int
+	// PendingRequests : Outgoing Requests, Including Ones Waiting On The Rate Limiter, Still In Flight
+	PendingRequests int
+	Err             error
+}
+
+func (e *ShutdownError) Error() string {
+	return fmt.Sprintf("shutdown Incomplete, Abandoned %d Queued Updates, %d Running Handlers And %d Pending Requests: %v",
+		e.QueuedUpdates, e.RunningHandlers, e.PendingRequests, e.Err)
+}
+
+func (e *ShutdownError) Unwrap() error {
+	return e.Err
+}
+
+type requestTracker struct {
+	pending atomic.Int64
+}
+
+func (t *requestTracker) begin() {
+	if t != nil {
+		t.pending.Add(1)
+	}
+}
+
+func (t *requestTracker) end() {
+	if t != nil {
+		t.pending.Add(-1)
+	}
+}
+
+func (t *requestTracker) count() int {
+	if t == nil {
+		return 0
+	}
+
+	return int(t.pending.Load())
+}
+
+// Shutdown : Stop Receiving Updates And Wait For Work In Progress To Finish
+// The Webhook Answers 503 And StartPolling Returns Once It Is Called. Shutdown Then Waits For Queued
+// And Running Handlers, Followed By Outgoing Requests, Returning A *ShutdownError If ctx Ends First
+func (b *Bot) Shutdown(ctx context.Context) error {
+	if b.dispatcher == nil {
+		b.dispatcher = newDispatcher(DispatchOptions{})
+	}
+
+	d := b.dispatcher
+
+	d.close()
+
+	handlersDone := make(chan struct{})
+
+	go func() {
+		d.active.Wait()
+		d.stop()
+		close(handlersDone)
+	}()
+
+	select {
+	case <-handlersDone:
+	case <-ctx.Done():
+		return b.abandoned(ctx.Err())
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for b.requests.count() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return b.abandoned(ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+func (b *Bot) abandoned(err error) error {
+	d := b.dispatcher
+
+	return &ShutdownError{
+		QueuedUpdates:   len(d.slots),
+		RunningHandlers: int(d.running.Load()),
+		PendingRequests: b.requests.count(),
+		Err:             err,
+	}
+}
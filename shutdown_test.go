@@ -0,0 +1,97 @@
+package goTelegram
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShutdownDrainsAndStopsWorkers(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{Dispatch: DispatchOptions{Workers: 2}})
+
+	var handled atomic.Int32
+
+	bot.SetHandler(func(Update) {
+		time.Sleep(5 * time.Millisecond)
+		handled.Add(1)
+	})
+
+	for i := 0; i < 10; i++ {
+		if err := bot.dispatch(context.Background(), Update{UpdateID: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := bot.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown returned %v", err)
+	}
+
+	if n := handled.Load(); n != 10 {
+		t.Errorf("Shutdown returned after %d of 10 updates were handled", n)
+	}
+
+	select {
+	case _, open := <-bot.dispatcher.queue:
+		if open {
+			t.Error("a job was left on the queue after Shutdown")
+		}
+	default:
+		t.Error("queue is still open after Shutdown, so the workers never exit")
+	}
+
+	if err := bot.dispatch(context.Background(), Update{UpdateID: 11}); !errors.Is(err, ErrBotShutdown) {
+		t.Errorf("dispatch after Shutdown returned %v, want ErrBotShutdown", err)
+	}
+
+	if code := postUpdate(bot, `{"update_id":12}`); code != http.StatusServiceUnavailable {
+		t.Errorf("webhook after Shutdown answered %d, want 503", code)
+	}
+
+	if err := bot.Shutdown(ctx); err != nil {
+		t.Errorf("second Shutdown returned %v", err)
+	}
+}
+
+func TestShutdownReportsAbandonedWork(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{Dispatch: DispatchOptions{Workers: 1}})
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	bot.SetHandler(func(update Update) {
+		if update.UpdateID == 1 {
+			close(started)
+		}
+
+		<-release
+	})
+
+	defer close(release)
+
+	for i := 1; i <= 3; i++ {
+		if err := bot.dispatch(context.Background(), Update{UpdateID: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var shutdownErr *ShutdownError
+
+	if err := bot.Shutdown(ctx); !errors.As(err, &shutdownErr) {
+		t.Fatalf("Shutdown returned %v, want a *ShutdownError", err)
+	}
+
+	if shutdownErr.RunningHandlers != 1 || shutdownErr.QueuedUpdates != 2 || !errors.Is(shutdownErr, context.DeadlineExceeded) {
+		t.Errorf("Shutdown reported %+v, want 1 running and 2 queued", shutdownErr)
+	}
+}
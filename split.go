@@ -0,0 +1,631 @@
+package goTelegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxMessageLength : Longest Text Telegram Accepts In A Message, In UTF-16 Code Units
+const MaxMessageLength = 4096
+
+// MaxCaptionLength : Longest Caption Telegram Accepts On A Photo, Video Or Media Group Item, In UTF-16 Code Units
+const MaxCaptionLength = 1024
+
+// ErrUnsplittable : Returned When A Link, Tag Or Escape Is Too Long To Fit In A Part On Its Own
+var ErrUnsplittable = errors.New("message Can't Be Split To Fit The Limit")
+
+// ErrCaptionTooLong : Returned Without Sending Anything When A Caption Is Over MaxCaptionLength
+var ErrCaptionTooLong = errors.New("caption Is Longer Than Telegram Allows")
+
+// MessagePart : One Piece Of Text Produced By SplitMessage
+type MessagePart struct {
+	Text     string
+	Entities []MessageEntity
+}
+
+// SendLongMessage : SendMessageWithOptions For Text Of Any Length
+// Text Longer Than MaxMessageLength Is Split With SplitMessage And The Parts Are Sent In Order,
+// With The Reply Attached To The First Part And The Keyboard To The Last One
+// If A Part Can't Be Sent, The Messages Already Sent Are Returned Along With The Error
+// Only Message Text Is Split, Media Senders Reject Captions Over MaxCaptionLength With ErrCaptionTooLong
+func (b *Bot) SendLongMessage(s string, c Chat, options MessageOptions) ([]Message, error) {
+	return b.SendLongMessageCtx(context.Background(), s, c, options)
+}
+
+// SendLongMessageCtx : SendLongMessage With A Context
+func (b *Bot) SendLongMessageCtx(ctx context.Context, s string, c Chat, options MessageOptions) ([]Message, error) {
+	parts, err := SplitMessage(s, options.ParseMode, options.Entities, MaxMessageLength)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) == 0 {
+		return nil, errors.New("message Has No Text To Send")
+	}
+
+	markup := b.markupFor(c.ID, options.ReplyMarkup)
+	messages := make([]Message, 0, len(parts))
+
+	for i, part := range parts {
+		partOptions := options
+		partOptions.Entities = part.Entities
+
+		if i > 0 {
+			partOptions.ReplyParameters = nil
+		}
+
+		var partMarkup ReplyMarkup
+
+		if i == len(parts)-1 {
+			partMarkup = markup
+		}
+
+		message, err := b.sendText(ctx, part.Text, c, partOptions, partMarkup)
+
+		if err != nil {
+			log.Printf("Part %d Of %d Wasn't Sent Successfully", i+1, len(parts))
+			return messages, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// checkCaption : Reject A Caption Telegram Would Refuse, Before Anything Is Uploaded
+func checkCaption(caption string) error {
+	if n := UTF16Len(caption); n > MaxCaptionLength {
+		return fmt.Errorf("%w: %d Units, The Limit Is %d", ErrCaptionTooLong, n, MaxCaptionLength)
+	}
+
+	return nil
+}
+
+// SplitMessage : Break text Into Parts No Longer Than limit UTF-16 Code Units
+// Text Is Split Between Paragraphs Where Possible, Then Between Lines, Then Between Words
+// With No parseMode, entities Are Clipped And Shifted To Match Each Part
+// With ParseModeHTML, ParseModeMarkdownV2 Or ParseModeMarkdown, Tags, Markers And Quotes Left Open
+// At A Split Are Closed And Reopened In The Next Part, Escapes Are Never Broken Up, And Length Is Measured On The
+// Raw Markup, So Every Part Is Guaranteed To Fit Once Rendered
+// Links, Entities And Escapes Are Kept Whole, ErrUnsplittable Is Returned If One Can't Fit In limit
+func SplitMessage(text, parseMode string, entities []MessageEntity, limit int) ([]MessagePart, error) {
+	if limit <= 0 {
+		limit = MaxMessageLength
+	}
+
+	var atoms []splitAtom
+
+	switch parseMode {
+	case ParseModeHTML:
+		atoms = htmlAtoms(text)
+	case ParseModeMarkdownV2:
+		atoms = markdownAtoms(text)
+	case ParseModeMarkdown:
+		atoms = legacyMarkdownAtoms(text)
+	default:
+		atoms = plainAtoms(text)
+	}
+
+	var parts []MessagePart
+
+	for _, chunk := range splitAtoms(atoms, limit) {
+		if parseMode != "" {
+			part, ok := chunk.markup(atoms)
+
+			if !ok {
+				continue
+			}
+
+			if n := UTF16Len(part.Text); n > limit {
+				return nil, fmt.Errorf("%w: Part %d Is %d Units, The Limit Is %d", ErrUnsplittable, len(parts)+1, n, limit)
+			}
+
+			parts = append(parts, part)
+
+			continue
+		}
+
+		from, to := chunk.from, chunk.to
+
+		for from < to && strings.TrimSpace(atoms[from].text) == "" {
+			from++
+		}
+
+		for to > from && strings.TrimSpace(atoms[to-1].text) == "" {
+			to--
+		}
+
+		if from == to {
+			continue
+		}
+
+		start, end := atoms[from].offset, atoms[to-1].offset+atoms[to-1].units
+
+		if end-start > limit {
+			return nil, fmt.Errorf("%w: Part %d Is %d Units, The Limit Is %d", ErrUnsplittable, len(parts)+1, end-start, limit)
+		}
+
+		parts = append(parts, MessagePart{
+			Text:     joinAtoms(atoms[from:to]),
+			Entities: clipEntities(entities, start, end),
+		})
+	}
+
+	return parts, nil
+}
+
+// splitTag : A Tag Or Marker Left Open Across A Split
+type splitTag struct {
+	name  string
+	open  string
+	close string
+}
+
+type splitAtom struct {
+	text   string
+	units  int
+	offset int
+	// sep : How Good A Place This Is To Split, 0 For Anywhere Inside A Word Up To 3 For Between Paragraphs
+	// The Atom Itself Is Dropped When The Text Is Split On It
+	sep    int
+	markup bool
+	// visible : Markup That Shows Up As Text, Such As A Link, An Escape Or An Entity
+	visible bool
+	push    *splitTag
+	pop     string
+}
+
+type splitChunk struct {
+	from, to int
+	reopen   []splitTag
+	closing  []splitTag
+}
+
+func (c splitChunk) markup(atoms []splitAtom) (MessagePart, bool) {
+	hasText := false
+
+	for _, a := range atoms[c.from:c.to] {
+		if (!a.markup || a.visible) && strings.TrimSpace(a.text) != "" {
+			hasText = true
+			break
+		}
+	}
+
+	if !hasText {
+		return MessagePart{}, false
+	}
+
+	var sb strings.Builder
+
+	for _, tag := range c.reopen {
+		sb.WriteString(tag.open)
+	}
+
+	sb.WriteString(joinAtoms(atoms[c.from:c.to]))
+
+	for i := len(c.closing) - 1; i >= 0; i-- {
+		sb.WriteString(c.closing[i].close)
+	}
+
+	return MessagePart{Text: sb.String()}, true
+}
+
+func joinAtoms(atoms []splitAtom) string {
+	var sb strings.Builder
+
+	for _, a := range atoms {
+		sb.WriteString(a.text)
+	}
+
+	return sb.String()
+}
+
+func applyAtom(stack []splitTag, a splitAtom) []splitTag {
+	if a.push != nil {
+		return append(stack, *a.push)
+	}
+
+	if a.pop != "" {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if stack[i].name == a.pop {
+				return append(stack[:i:i], stack[i+1:]...)
+			}
+		}
+	}
+
+	return stack
+}
+
+func tagUnits(stack []splitTag, closing bool) int {
+	n := 0
+
+	for _, tag := range stack {
+		if closing {
+			n += UTF16Len(tag.close)
+		} else {
+			n += UTF16Len(tag.open)
+		}
+	}
+
+	return n
+}
+
+// splitAtoms : Group atoms Into Chunks That, Along With Their Reopened And Closing Tags, Fit In limit
+func splitAtoms(atoms []splitAtom, limit int) []splitChunk {
+	var (
+		chunks []splitChunk
+		stack  []splitTag
+	)
+
+	for start := 0; start < len(atoms); {
+		reopen := append([]splitTag(nil), stack...)
+		current := append([]splitTag(nil), stack...)
+		used := tagUnits(reopen, false)
+
+		best, bestSep := -1, -1
+		var bestStack []splitTag
+
+		i := start
+
+		for ; i < len(atoms); i++ {
+			a := atoms[i]
+
+			if i > start && !a.markup && used+tagUnits(current, true) <= limit {
+				sep := a.sep
+
+				// A Split Early On Would Leave A Short Part, So It Only Wins If Nothing Later Fits
+				if used < limit/2 {
+					sep = 0
+				}
+
+				if sep >= bestSep {
+					best, bestSep = i, sep
+					bestStack = append(bestStack[:0:0], current...)
+				}
+			}
+
+			if used+a.units > limit && i > start {
+				break
+			}
+
+			used += a.units
+			current = applyAtom(current, a)
+		}
+
+		if i == len(atoms) && (used+tagUnits(current, true) <= limit || best == -1) {
+			chunks = append(chunks, splitChunk{from: start, to: i, reopen: reopen, closing: current})
+			break
+		}
+
+		if best == -1 {
+			best, bestSep, bestStack = i, 0, current
+		}
+
+		chunks = append(chunks, splitChunk{from: start, to: best, reopen: reopen, closing: bestStack})
+		stack = bestStack
+		start = best
+
+		// The Separator Is Dropped, But It Can Still End A Quote Line
+		if bestSep > 0 {
+			stack = applyAtom(stack, atoms[best])
+			start++
+		}
+	}
+
+	return chunks
+}
+
+func clipEntities(entities []MessageEntity, from, to int) []MessageEntity {
+	var clipped []MessageEntity
+
+	for _, e := range entities {
+		start, end := max(e.Offset, from), min(e.Offset+e.Length, to)
+
+		if end <= start {
+			continue
+		}
+
+		e.Offset, e.Length = start-from, end-start
+		clipped = append(clipped, e)
+	}
+
+	return clipped
+}
+
+// atomBuilder : Turns Text Into Atoms, Keeping Track Of Offsets And Separators
+type atomBuilder struct {
+	atoms  []splitAtom
+	offset int
+}
+
+func (ab *atomBuilder) add(a splitAtom) {
+	a.units = UTF16Len(a.text)
+	a.offset = ab.offset
+	ab.offset += a.units
+	ab.atoms = append(ab.atoms, a)
+}
+
+func (ab *atomBuilder) text(r rune) {
+	a := splitAtom{text: string(r)}
+
+	switch r {
+	case ' ', '\t':
+		a.sep = 1
+	case '\n':
+		a.sep = 2
+
+		if n := len(ab.atoms); n > 0 && ab.atoms[n-1].text == "\n" {
+			a.sep = 3
+		}
+	}
+
+	ab.add(a)
+}
+
+func plainAtoms(text string) []splitAtom {
+	var ab atomBuilder
+
+	for _, r := range text {
+		ab.text(r)
+	}
+
+	return ab.atoms
+}
+
+func htmlAtoms(text string) []splitAtom {
+	var ab atomBuilder
+
+	for i := 0; i < len(text); {
+		switch text[i] {
+		case '<':
+			end := strings.IndexByte(text[i:], '>')
+
+			if end == -1 {
+				break
+			}
+
+			tag := text[i : i+end+1]
+			atom := splitAtom{text: tag, markup: true}
+
+			if strings.HasPrefix(tag, "</") {
+				atom.pop = htmlTagName(tag[2:])
+			} else {
+				name := htmlTagName(tag[1:])
+				atom.push = &splitTag{name: name, open: tag, close: "</" + name + ">"}
+			}
+
+			ab.add(atom)
+			i += end + 1
+
+			continue
+		case '&':
+			end := strings.IndexByte(text[i:], ';')
+
+			if end == -1 || end > 10 {
+				break
+			}
+
+			ab.add(splitAtom{text: text[i : i+end+1], markup: true, visible: true})
+			i += end + 1
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(text[i:])
+		ab.text(r)
+		i += size
+	}
+
+	return ab.atoms
+}
+
+func htmlTagName(s string) string {
+	end := strings.IndexAny(s, " \t\n>/")
+
+	if end == -1 {
+		end = len(s)
+	}
+
+	return strings.ToLower(s[:end])
+}
+
+func markdownAtoms(text string) []splitAtom {
+	var (
+		ab     atomBuilder
+		open   = map[string]bool{}
+		inCode string
+		// quoteLine : The Current Line Started With >, So Its Newline Ends The Quote Tag
+		quoteLine bool
+		// expandable : Inside A **> Quote, Which Ends With || At The End Of A Line Rather Than A Spoiler
+		expandable bool
+	)
+
+	lineStart := true
+
+	for i := 0; i < len(text); {
+		rest := text[i:]
+		atom := splitAtom{markup: true}
+
+		switch {
+		case rest[0] == '\\' && len(rest) > 1:
+			_, size := utf8.DecodeRuneInString(rest[1:])
+			atom.text, atom.visible = rest[:1+size], true
+		case inCode != "":
+			if !strings.HasPrefix(rest, inCode) {
+				atom.text = ""
+				break
+			}
+
+			atom.text, atom.pop = inCode, inCode
+			inCode = ""
+		case strings.HasPrefix(rest, "```"):
+			opener := "```"
+
+			if end := strings.IndexByte(rest, '\n'); end != -1 {
+				opener = rest[:end+1]
+			}
+
+			atom.text = opener
+			atom.push = &splitTag{name: "```", open: opener, close: "```"}
+			inCode = "```"
+		case rest[0] == '`':
+			atom.text = "`"
+			atom.push = &splitTag{name: "`", open: "`", close: "`"}
+			inCode = "`"
+		case rest[0] == '[' || strings.HasPrefix(rest, "!["):
+			atom.text, atom.visible = markdownLink(rest), true
+		case lineStart && strings.HasPrefix(rest, "**>"):
+			// The ** Stays Open Until The Closing ||, The > Is Reopened Like Any Other Quote Line
+			atom.text = "**"
+			atom.push = &splitTag{name: "**>", open: "**", close: "||"}
+			expandable = true
+		case lineStart && rest[0] == '>':
+			atom.text = ">"
+			atom.push = &splitTag{name: ">", open: ">"}
+			quoteLine = true
+		case expandable && !open["||"] && strings.HasPrefix(rest, "||") && (len(rest) == 2 || rest[2] == '\n'):
+			atom.text, atom.pop = "||", "**>"
+			expandable = false
+		case strings.HasPrefix(rest, "||"), strings.HasPrefix(rest, "__"):
+			atom.text = rest[:2]
+		case strings.ContainsRune("*_~", rune(rest[0])):
+			atom.text = rest[:1]
+		}
+
+		if atom.text == "" {
+			r, size := utf8.DecodeRuneInString(rest)
+			ab.text(r)
+			i += size
+
+			if lineStart = r == '\n'; lineStart && quoteLine {
+				ab.atoms[len(ab.atoms)-1].pop = ">"
+				quoteLine = false
+			}
+
+			continue
+		}
+
+		if atom.push == nil && atom.pop == "" && inCode == "" && strings.ContainsRune("*_~|", rune(atom.text[0])) {
+			marker := atom.text
+
+			if open[marker] {
+				atom.pop = marker
+			} else {
+				atom.push = &splitTag{name: marker, open: marker, close: marker}
+			}
+
+			open[marker] = !open[marker]
+		}
+
+		ab.add(atom)
+		// The > Of A **> Still Starts The Line
+		lineStart = atom.push != nil && atom.push.name == "**>"
+		i += len(atom.text)
+	}
+
+	return ab.atoms
+}
+
+// legacyMarkdownAtoms : Atoms For ParseModeMarkdown, Where Entities Can't Nest And Only _*`[ Can Be Escaped
+func legacyMarkdownAtoms(text string) []splitAtom {
+	var (
+		ab atomBuilder
+		// inside : The Marker That Closes The Open Entity, Nothing Else Is Special Until It Shows Up
+		inside string
+	)
+
+	for i := 0; i < len(text); {
+		rest := text[i:]
+		atom := splitAtom{markup: true}
+
+		switch {
+		case inside != "":
+			if strings.HasPrefix(rest, inside) {
+				atom.text, atom.pop = inside, inside
+				inside = ""
+			}
+		case rest[0] == '\\' && len(rest) > 1 && strings.ContainsRune("_*`[", rune(rest[1])):
+			atom.text, atom.visible = rest[:2], true
+		case strings.HasPrefix(rest, "```"):
+			opener := "```"
+
+			if end := strings.IndexByte(rest, '\n'); end != -1 {
+				opener = rest[:end+1]
+			}
+
+			atom.text = opener
+			atom.push = &splitTag{name: "```", open: opener, close: "```"}
+			inside = "```"
+		case rest[0] == '[':
+			atom.text, atom.visible = markdownLink(rest), true
+		case strings.ContainsRune("*_`", rune(rest[0])):
+			atom.text = rest[:1]
+			atom.push = &splitTag{name: atom.text, open: atom.text, close: atom.text}
+			inside = atom.text
+		}
+
+		if atom.text == "" {
+			r, size := utf8.DecodeRuneInString(rest)
+			ab.text(r)
+			i += size
+
+			continue
+		}
+
+		ab.add(atom)
+		i += len(atom.text)
+	}
+
+	return ab.atoms
+}
+
+// markdownLink : The Whole [text](url) Starting At s, Kept In One Piece, Or "" If s Doesn't Start A Link
+func markdownLink(s string) string {
+	depth := 0
+	inURL := false
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case !inURL && s[i] == '[':
+			depth++
+		case !inURL && s[i] == ']':
+			depth--
+
+			if depth == 0 {
+				if !strings.HasPrefix(s[i+1:], "(") {
+					return ""
+				}
+
+				inURL = true
+				i++
+			}
+		case inURL && s[i] == ')':
+			return s[:i+1]
+		}
+	}
+
+	return ""
+}
+
+// UTF16Len : Length Of s In UTF-16 Code Units, Which Is How Telegram Measures Text
+func UTF16Len(s string) int {
+	n := 0
+
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,234 @@
+package goTelegram
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitMessage(t *testing.T) {
+	bold := []MessageEntity{{Type: "bold", Offset: 6, Length: 5}}
+
+	tests := []struct {
+		name      string
+		text      string
+		parseMode string
+		entities  []MessageEntity
+		limit     int
+		want      []MessagePart
+		wantErr   error
+	}{
+		{name: "empty", text: "", parseMode: ParseModeHTML, limit: 10},
+		{name: "fits", text: "hello", limit: 10, want: []MessagePart{{Text: "hello"}}},
+		{
+			name:  "between words",
+			text:  "aaaa bbbb cccc",
+			limit: 9,
+			want:  []MessagePart{{Text: "aaaa bbbb"}, {Text: "cccc"}},
+		},
+		{
+			name:  "between paragraphs",
+			text:  "para one\n\npara two",
+			limit: 12,
+			want:  []MessagePart{{Text: "para one"}, {Text: "para two"}},
+		},
+		{
+			name:     "entities are clipped and shifted",
+			text:     "hello world",
+			entities: bold,
+			limit:    6,
+			want:     []MessagePart{{Text: "hello"}, {Text: "world", Entities: []MessageEntity{{Type: "bold", Offset: 0, Length: 5}}}},
+		},
+		{name: "markdown link only", text: "[hi](http://x.com)", parseMode: ParseModeMarkdownV2, limit: 4096, want: []MessagePart{{Text: "[hi](http://x.com)"}}},
+		{name: "formatted link", text: "[click](http://a.b)", parseMode: ParseModeMarkdownV2, limit: 4096, want: []MessagePart{{Text: "[click](http://a.b)"}}},
+		{name: "markdown escapes only", text: `\!\!\!`, parseMode: ParseModeMarkdownV2, limit: 4096, want: []MessagePart{{Text: `\!\!\!`}}},
+		{name: "html entities only", text: "&amp;&lt;", parseMode: ParseModeHTML, limit: 4096, want: []MessagePart{{Text: "&amp;&lt;"}}},
+		{
+			name:      "html tags reopened",
+			text:      "<b>aaaa bbbb</b>",
+			parseMode: ParseModeHTML,
+			limit:     12,
+			want:      []MessagePart{{Text: "<b>aaaa</b>"}, {Text: "<b>bbbb</b>"}},
+		},
+		{
+			name:      "markdown markers reopened",
+			text:      "*aaaa bbbb*",
+			parseMode: ParseModeMarkdownV2,
+			limit:     8,
+			want:      []MessagePart{{Text: "*aaaa*"}, {Text: "*bbbb*"}},
+		},
+		{
+			name:      "markdown escapes kept whole",
+			text:      `aaa\.\. bbb`,
+			parseMode: ParseModeMarkdownV2,
+			limit:     8,
+			want:      []MessagePart{{Text: `aaa\.\.`}, {Text: "bbb"}},
+		},
+		{
+			name:      "quote reopened mid-line",
+			text:      ">aaaa bbbb",
+			parseMode: ParseModeMarkdownV2,
+			limit:     6,
+			want:      []MessagePart{{Text: ">aaaa"}, {Text: ">bbbb"}},
+		},
+		{
+			name:      "quote lines split between lines",
+			text:      ">aaaa\n>bbbb\ncc",
+			parseMode: ParseModeMarkdownV2,
+			limit:     7,
+			want:      []MessagePart{{Text: ">aaaa"}, {Text: ">bbbb"}, {Text: "cc"}},
+		},
+		{
+			name:      "expandable quote closed and reopened",
+			text:      "**>aaaa\n>bbbb||",
+			parseMode: ParseModeMarkdownV2,
+			limit:     10,
+			want:      []MessagePart{{Text: "**>aaaa||"}, {Text: "**>bbbb||"}},
+		},
+		{
+			name:      "expandable quote end isn't a spoiler",
+			text:      "**>aa||\ncccc dddd",
+			parseMode: ParseModeMarkdownV2,
+			limit:     7,
+			want:      []MessagePart{{Text: "**>aa||"}, {Text: "cccc"}, {Text: "dddd"}},
+		},
+		{
+			name:      "legacy markdown markers reopened",
+			text:      "*aaaa bbbb*",
+			parseMode: ParseModeMarkdown,
+			limit:     8,
+			want:      []MessagePart{{Text: "*aaaa*"}, {Text: "*bbbb*"}},
+		},
+		{
+			name:      "legacy markdown markers don't nest",
+			text:      "*a_b cc*",
+			parseMode: ParseModeMarkdown,
+			limit:     6,
+			want:      []MessagePart{{Text: "*a_b*"}, {Text: "*cc*"}},
+		},
+		{
+			name:      "legacy markdown escapes",
+			text:      `\_aaaa _bb\cc_`,
+			parseMode: ParseModeMarkdown,
+			limit:     8,
+			want:      []MessagePart{{Text: `\_aaaa`}, {Text: `_bb\cc_`}},
+		},
+		{name: "link over the limit", text: "[a long link text](http://x.com)", parseMode: ParseModeMarkdownV2, limit: 10, wantErr: ErrUnsplittable},
+		{name: "tag over the limit", text: `aa <a href="http://x">link</a> bb`, parseMode: ParseModeHTML, limit: 8, wantErr: ErrUnsplittable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := SplitMessage(tt.text, tt.parseMode, tt.entities, tt.limit)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SplitMessage error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(parts, tt.want) {
+				t.Errorf("SplitMessage = %#v, want %#v", parts, tt.want)
+			}
+
+			for _, part := range parts {
+				if n := UTF16Len(part.Text); n > tt.limit {
+					t.Errorf("part %q is %d units, over the limit of %d", part.Text, n, tt.limit)
+				}
+			}
+		})
+	}
+}
+
+func TestSendLongMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		parseMode string
+		wantSent  int
+		wantErr   bool
+	}{
+		{name: "link only", text: "[hi](http://x.com)", parseMode: ParseModeMarkdownV2, wantSent: 1},
+		{name: "long plain text", text: strings.Repeat("word ", 2000), wantSent: 3},
+		{name: "empty", text: "", wantErr: true},
+		{name: "unsplittable link", text: "[x](http://" + strings.Repeat("a", MaxMessageLength) + ")", parseMode: ParseModeMarkdownV2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+
+			api.handle("sendMessage", func([]byte) (int, string) {
+				return http.StatusOK, `{"ok":true,"result":{"message_id":1,"chat":{"id":5}}}`
+			})
+
+			bot := newTestBot(t, api, BotOptions{RateLimit: RateLimitOptions{Disabled: true}})
+			markup := NewInlineKeyboard().Row(CallbackButton("ok", "ok"))
+			keyboard, _ := markup.Markup()
+
+			messages, err := bot.SendLongMessage(tt.text, Chat{ID: 5}, MessageOptions{ParseMode: tt.parseMode, ReplyMarkup: keyboard})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SendLongMessage error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			sent := api.requests("sendMessage")
+
+			if len(messages) != tt.wantSent || len(sent) != tt.wantSent {
+				t.Fatalf("sent %d messages and returned %d, want %d", len(sent), len(messages), tt.wantSent)
+			}
+
+			for i, body := range sent {
+				var req struct {
+					ReplyMarkup json.RawMessage `json:"reply_markup"`
+				}
+
+				_ = json.Unmarshal(body, &req)
+
+				if last := i == len(sent)-1; last != (len(req.ReplyMarkup) > 0) {
+					t.Errorf("part %d: keyboard attached = %v, want it only on the last part", i+1, !last)
+				}
+			}
+		})
+	}
+}
+
+func TestCaptionTooLong(t *testing.T) {
+	long := strings.Repeat("a", MaxCaptionLength+1)
+
+	tests := []struct {
+		name string
+		send func(bot *Bot) error
+	}{
+		{name: "photo", send: func(bot *Bot) error {
+			_, err := bot.SendPhoto("file-id", long, Chat{ID: 5}, MediaOptions{})
+			return err
+		}},
+		{name: "video from memory", send: func(bot *Bot) error {
+			_, err := bot.SendVideoFromMemory([]byte("v"), long, Chat{ID: 5}, MediaOptions{})
+			return err
+		}},
+		{name: "media group", send: func(bot *Bot) error {
+			_, err := bot.SendMediaGroup([]InputMedia{{Type: "photo", Media: "a"}, {Type: "photo", Media: "b", Caption: long}}, Chat{ID: 5}, MediaOptions{})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			bot := newTestBot(t, api, BotOptions{})
+
+			if err := tt.send(bot); !errors.Is(err, ErrCaptionTooLong) {
+				t.Errorf("sending returned %v, want ErrCaptionTooLong", err)
+			}
+
+			for _, method := range []string{"sendPhoto", "sendVideo", "sendMediaGroup"} {
+				if sent := api.requests(method); len(sent) != 0 {
+					t.Errorf("%s was called %d times for a caption over the limit", method, len(sent))
+				}
+			}
+		})
+	}
+}
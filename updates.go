@@ -0,0 +1,298 @@
+package goTelegram
+
+import "encoding/json"
+
+// UpdateType : Kind Of Update, Stored In Update.Type
+type UpdateType string
+
+// Update Types Set By UpdateHandler And StartPolling
+// The Names Of Kinds Other Than Plain Messages Match Telegram's, So They Can Also Be Used In allowed_updates
+const (
+	UpdateText                    UpdateType = "text"
+	UpdateEditedText              UpdateType = "edited_text"
+	UpdateCallback                UpdateType = "callback"
+	UpdateDocument                UpdateType = "document"
+	UpdateMediaGroup              UpdateType = "media_group"
+	UpdatePhoto                   UpdateType = "photo"
+	UpdateVideo                   UpdateType = "video"
+	UpdateMessage                 UpdateType = "message"
+	UpdateEditedMessage           UpdateType = "edited_message"
+	UpdateChannelPost             UpdateType = "channel_post"
+	UpdateEditedChannelPost       UpdateType = "edited_channel_post"
+	UpdateBusinessConnection      UpdateType = "business_connection"
+	UpdateBusinessMessage         UpdateType = "business_message"
+	UpdateEditedBusinessMessage   UpdateType = "edited_business_message"
+	UpdateDeletedBusinessMessages UpdateType = "deleted_business_messages"
+	UpdateMessageReaction         UpdateType = "message_reaction"
+	UpdateMessageReactionCount    UpdateType = "message_reaction_count"
+	UpdateInlineQuery             UpdateType = "inline_query"
+	UpdateChosenInlineResult      UpdateType = "chosen_inline_result"
+	UpdateShippingQuery           UpdateType = "shipping_query"
+	UpdatePreCheckoutQuery        UpdateType = "pre_checkout_query"
+	UpdatePurchasedPaidMedia      UpdateType = "purchased_paid_media"
+	UpdatePoll                    UpdateType = "poll"
+	UpdatePollAnswer              UpdateType = "poll_answer"
+	UpdateMyChatMember            UpdateType = "my_chat_member"
+	UpdateChatMember              UpdateType = "chat_member"
+	UpdateChatJoinRequest         UpdateType = "chat_join_request"
+	UpdateChatBoost               UpdateType = "chat_boost"
+	UpdateRemovedChatBoost        UpdateType = "removed_chat_boost"
+	UpdateUnknown                 UpdateType = "unknown"
+)
+
+// CallbackQuery : Sent When A User Presses An Inline Keyboard Button
+type CallbackQuery struct {
+	ID              string  `json:"id"`
+	From            User    `json:"from"`
+	Message         Message `json:"message"`
+	InlineMessageID string  `json:"inline_message_id,omitempty"`
+	ChatInstance    string  `json:"chat_instance"`
+	Data            string  `json:"data"`
+	GameShortName   string  `json:"game_short_name,omitempty"`
+	// Payload : The Payload Data Was Encoded From With Bot.CallbackData, Read It With DecodePayload
+	Payload    json.RawMessage `json:"-"`
+	payloadErr error
+}
+
+// InlineQuery : An Incoming Inline Query
+type InlineQuery struct {
+	ID       string    `json:"id"`
+	From     User      `json:"from"`
+	Query    string    `json:"query"`
+	Offset   string    `json:"offset"`
+	ChatType string    `json:"chat_type,omitempty"`
+	Location *Location `json:"location,omitempty"`
+}
+
+// ChosenInlineResult : An Inline Result The User Picked And Sent To Their Chat
+type ChosenInlineResult struct {
+	ResultID        string    `json:"result_id"`
+	From            User      `json:"from"`
+	Location        *Location `json:"location,omitempty"`
+	InlineMessageID string    `json:"inline_message_id,omitempty"`
+	Query           string    `json:"query"`
+}
+
+// Location : A Point On The Map
+type Location struct {
+	Latitude             float64 `json:"latitude"`
+	Longitude            float64 `json:"longitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int     `json:"live_period,omitempty"`
+	Heading              int     `json:"heading,omitempty"`
+	ProximityAlertRadius int     `json:"proximity_alert_radius,omitempty"`
+}
+
+// ShippingQuery : Sent For Invoices With A Flexible Price Once The User Enters An Address
+type ShippingQuery struct {
+	ID              string          `json:"id"`
+	From            User            `json:"from"`
+	InvoicePayload  string          `json:"invoice_payload"`
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+// ShippingAddress : Address Entered For A Shipping Query
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"`
+	State       string `json:"state"`
+	City        string `json:"city"`
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2"`
+	PostCode    string `json:"post_code"`
+}
+
+// PreCheckoutQuery : Sent Right Before A Payment Is Confirmed
+type PreCheckoutQuery struct {
+	ID               string     `json:"id"`
+	From             User       `json:"from"`
+	Currency         string     `json:"currency"`
+	TotalAmount      int        `json:"total_amount"`
+	InvoicePayload   string     `json:"invoice_payload"`
+	ShippingOptionID string     `json:"shipping_option_id,omitempty"`
+	OrderInfo        *OrderInfo `json:"order_info,omitempty"`
+}
+
+// OrderInfo : Details The User Entered While Paying
+type OrderInfo struct {
+	Name            string           `json:"name,omitempty"`
+	PhoneNumber     string           `json:"phone_number,omitempty"`
+	Email           string           `json:"email,omitempty"`
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"`
+}
+
+// PaidMediaPurchased : Sent When A User Buys Paid Media With A Payload Set By The Bot
+type PaidMediaPurchased struct {
+	From             User   `json:"from"`
+	PaidMediaPayload string `json:"paid_media_payload"`
+}
+
+// Poll : State Of A Poll
+type Poll struct {
+	ID                    string          `json:"id"`
+	Question              string          `json:"question"`
+	QuestionEntities      []MessageEntity `json:"question_entities,omitempty"`
+	Options               []PollOption    `json:"options"`
+	TotalVoterCount       int             `json:"total_voter_count"`
+	IsClosed              bool            `json:"is_closed"`
+	IsAnonymous           bool            `json:"is_anonymous"`
+	Type                  string          `json:"type"`
+	AllowsMultipleAnswers bool            `json:"allows_multiple_answers"`
+	CorrectOptionID       *int            `json:"correct_option_id,omitempty"`
+	Explanation           string          `json:"explanation,omitempty"`
+	ExplanationEntities   []MessageEntity `json:"explanation_entities,omitempty"`
+	OpenPeriod            int             `json:"open_period,omitempty"`
+	CloseDate             int             `json:"close_date,omitempty"`
+}
+
+// PollOption : One Answer In A Poll
+type PollOption struct {
+	Text         string          `json:"text"`
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+	VoterCount   int             `json:"voter_count"`
+}
+
+// PollAnswer : A User Changed Their Answer In A Non-Anonymous Poll
+type PollAnswer struct {
+	PollID    string `json:"poll_id"`
+	VoterChat *Chat  `json:"voter_chat,omitempty"`
+	User      *User  `json:"user,omitempty"`
+	OptionIDs []int  `json:"option_ids"`
+}
+
+// ChatMemberUpdated : A Chat Member's Status Changed
+type ChatMemberUpdated struct {
+	Chat                    Chat            `json:"chat"`
+	From                    User            `json:"from"`
+	Date                    int             `json:"date"`
+	OldChatMember           ChatMember      `json:"old_chat_member"`
+	NewChatMember           ChatMember      `json:"new_chat_member"`
+	InviteLink              *ChatInviteLink `json:"invite_link,omitempty"`
+	ViaJoinRequest          bool            `json:"via_join_request,omitempty"`
+	ViaChatFolderInviteLink bool            `json:"via_chat_folder_invite_link,omitempty"`
+}
+
+// ChatMember : A Member Of A Chat
+// Status Is One Of "creator", "administrator", "member", "restricted", "left" Or "kicked",
+// And Only The Fields Telegram Sends For That Status Are Filled In
+type ChatMember struct {
+	Status              string `json:"status"`
+	User                User   `json:"user"`
+	IsAnonymous         bool   `json:"is_anonymous,omitempty"`
+	CustomTitle         string `json:"custom_title,omitempty"`
+	IsMember            bool   `json:"is_member,omitempty"`
+	UntilDate           int    `json:"until_date,omitempty"`
+	CanBeEdited         bool   `json:"can_be_edited,omitempty"`
+	CanManageChat       bool   `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages   bool   `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats bool   `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers  bool   `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers   bool   `json:"can_promote_members,omitempty"`
+	CanChangeInfo       bool   `json:"can_change_info,omitempty"`
+	CanInviteUsers      bool   `json:"can_invite_users,omitempty"`
+	CanPostMessages     bool   `json:"can_post_messages,omitempty"`
+	CanEditMessages     bool   `json:"can_edit_messages,omitempty"`
+	CanPinMessages      bool   `json:"can_pin_messages,omitempty"`
+	CanManageTopics     bool   `json:"can_manage_topics,omitempty"`
+	CanSendMessages     bool   `json:"can_send_messages,omitempty"`
+}
+
+// ChatInviteLink : An Invite Link For A Chat
+type ChatInviteLink struct {
+	InviteLink              string `json:"invite_link"`
+	Creator                 User   `json:"creator"`
+	CreatesJoinRequest      bool   `json:"creates_join_request"`
+	IsPrimary               bool   `json:"is_primary"`
+	IsRevoked               bool   `json:"is_revoked"`
+	Name                    string `json:"name,omitempty"`
+	ExpireDate              int    `json:"expire_date,omitempty"`
+	MemberLimit             int    `json:"member_limit,omitempty"`
+	PendingJoinRequestCount int    `json:"pending_join_request_count,omitempty"`
+}
+
+// ChatJoinRequest : A User Asked To Join A Chat
+type ChatJoinRequest struct {
+	Chat       Chat            `json:"chat"`
+	From       User            `json:"from"`
+	UserChatID int             `json:"user_chat_id"`
+	Date       int             `json:"date"`
+	Bio        string          `json:"bio,omitempty"`
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+// MessageReactionUpdated : A User Changed Their Reaction To A Message
+type MessageReactionUpdated struct {
+	Chat        Chat           `json:"chat"`
+	MessageID   int            `json:"message_id"`
+	User        *User          `json:"user,omitempty"`
+	ActorChat   *Chat          `json:"actor_chat,omitempty"`
+	Date        int            `json:"date"`
+	OldReaction []ReactionType `json:"old_reaction"`
+	NewReaction []ReactionType `json:"new_reaction"`
+}
+
+// MessageReactionCountUpdated : Anonymous Reactions To A Message Changed
+type MessageReactionCountUpdated struct {
+	Chat      Chat            `json:"chat"`
+	MessageID int             `json:"message_id"`
+	Date      int             `json:"date"`
+	Reactions []ReactionCount `json:"reactions"`
+}
+
+// ReactionType : An Emoji, Custom Emoji Or Paid Reaction
+type ReactionType struct {
+	Type          string `json:"type"`
+	Emoji         string `json:"emoji,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// ReactionCount : How Many Times A Reaction Was Added To A Message
+type ReactionCount struct {
+	Type       ReactionType `json:"type"`
+	TotalCount int          `json:"total_count"`
+}
+
+// BusinessConnection : The Bot Was Connected To Or Disconnected From A Business Account
+type BusinessConnection struct {
+	ID         string `json:"id"`
+	User       User   `json:"user"`
+	UserChatID int    `json:"user_chat_id"`
+	Date       int    `json:"date"`
+	IsEnabled  bool   `json:"is_enabled"`
+}
+
+// BusinessMessagesDeleted : Messages Were Deleted From A Connected Business Account
+type BusinessMessagesDeleted struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	Chat                 Chat   `json:"chat"`
+	MessageIDs           []int  `json:"message_ids"`
+}
+
+// ChatBoostUpdated : A Chat Was Boosted Or A Boost Changed
+type ChatBoostUpdated struct {
+	Chat  Chat      `json:"chat"`
+	Boost ChatBoost `json:"boost"`
+}
+
+// ChatBoostRemoved : A Boost Was Removed From A Chat
+type ChatBoostRemoved struct {
+	Chat       Chat            `json:"chat"`
+	BoostID    string          `json:"boost_id"`
+	RemoveDate int             `json:"remove_date"`
+	Source     ChatBoostSource `json:"source"`
+}
+
+// ChatBoost : A Single Boost Of A Chat
+type ChatBoost struct {
+	BoostID        string          `json:"boost_id"`
+	AddDate        int             `json:"add_date"`
+	ExpirationDate int             `json:"expiration_date"`
+	Source         ChatBoostSource `json:"source"`
+}
+
+// ChatBoostSource : Where A Boost Came From, Source Is "premium", "gift_code" Or "giveaway"
+type ChatBoostSource struct {
+	Source            string `json:"source"`
+	User              *User  `json:"user,omitempty"`
+	GiveawayMessageID int    `json:"giveaway_message_id,omitempty"`
+	IsUnclaimed       bool   `json:"is_unclaimed,omitempty"`
+}
@@ -0,0 +1,243 @@
+package goTelegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var telegramSubnets = []string{"149.154.160.0/20", "91.108.4.0/22"}
+
+// TelegramSubnets : IP Ranges Telegram Sends Webhook Requests From, For Use With AllowSourceIPs
+func TelegramSubnets() []string {
+	return append([]string(nil), telegramSubnets...)
+}
+
+// webhookGuard : Checks Applied To Incoming Webhook Requests, Safe To Change While Requests Are Served
+type webhookGuard struct {
+	mu              sync.RWMutex
+	secretToken     string
+	allowedNetworks []*net.IPNet
+}
+
+// WebhookOptions : Parameters Used When Registering A Webhook
+type WebhookOptions struct {
+	// URL : HTTPS URL Telegram Should Send Updates To
+	URL string
+	// Certificate : Path To A Self-Signed Public Key Certificate, Uploaded Alongside The Request
+	Certificate        string
+	IPAddress          string
+	MaxConnections     int
+	AllowedUpdates     []string
+	DropPendingUpdates bool
+	// SecretToken : Sent Back By Telegram In The X-Telegram-Bot-Api-Secret-Token Header
+	SecretToken string
+}
+
+// WebhookInfo : Current Status Of The Bot's Webhook
+type WebhookInfo struct {
+	URL                          string   `json:"url"`
+	HasCustomCertificate         bool     `json:"has_custom_certificate"`
+	PendingUpdateCount           int      `json:"pending_update_count"`
+	IPAddress                    string   `json:"ip_address,omitempty"`
+	LastErrorDate                int      `json:"last_error_date,omitempty"`
+	LastErrorMessage             string   `json:"last_error_message,omitempty"`
+	LastSynchronizationErrorDate int      `json:"last_synchronization_error_date,omitempty"`
+	MaxConnections               int      `json:"max_connections,omitempty"`
+	AllowedUpdates               []string `json:"allowed_updates,omitempty"`
+}
+
+type webhookBody struct {
+	URL                string   `json:"url"`
+	IPAddress          string   `json:"ip_address,omitempty"`
+	MaxConnections     int      `json:"max_connections,omitempty"`
+	AllowedUpdates     []string `json:"allowed_updates,omitempty"`
+	DropPendingUpdates bool     `json:"drop_pending_updates,omitempty"`
+	SecretToken        string   `json:"secret_token,omitempty"`
+}
+
+type deleteWebhookBody struct {
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+}
+
+// SetWebhook : Tell Telegram Where To Send Updates For This Bot
+func (b *Bot) SetWebhook(options WebhookOptions) error {
+	return b.SetWebhookCtx(context.Background(), options)
+}
+
+// SetWebhookCtx : SetWebhook With A Context
+func (b *Bot) SetWebhookCtx(ctx context.Context, options WebhookOptions) error {
+	if options.URL == "" {
+		return errors.New("no Webhook URL Provided")
+	}
+
+	body := webhookBody{
+		URL:                options.URL,
+		IPAddress:          options.IPAddress,
+		MaxConnections:     options.MaxConnections,
+		AllowedUpdates:     options.AllowedUpdates,
+		DropPendingUpdates: options.DropPendingUpdates,
+		SecretToken:        options.SecretToken,
+	}
+
+	if options.Certificate == "" {
+		err := b.Call(ctx, "setWebhook", body, nil)
+
+		if err != nil {
+			log.Println("Webhook Wasn't Updated Successfully, Check Error Logs For Details")
+			return err
+		}
+
+		b.SetSecretToken(options.SecretToken)
+
+		return nil
+	}
+
+	cert, err := os.Open(options.Certificate)
+
+	if err != nil {
+		log.Println("Couldn't Open Specified Certificate For Reading")
+		return err
+	}
+
+	defer func() { _ = cert.Close() }()
+
+	err = b.CallMultipart(ctx, "setWebhook", body, []InputFile{{Field: "certificate", Name: filepath.Base(options.Certificate), Reader: cert}}, nil)
+
+	if err != nil {
+		log.Println("Webhook Wasn't Updated Successfully, Check Error Logs For Details")
+		return err
+	}
+
+	b.SetSecretToken(options.SecretToken)
+
+	return nil
+}
+
+// DeleteWebhook : Remove The Bot's Webhook So Updates Can Be Fetched With StartPolling
+func (b *Bot) DeleteWebhook(dropPendingUpdates bool) error {
+	return b.DeleteWebhookCtx(context.Background(), dropPendingUpdates)
+}
+
+// DeleteWebhookCtx : DeleteWebhook With A Context
+func (b *Bot) DeleteWebhookCtx(ctx context.Context, dropPendingUpdates bool) error {
+	err := b.Call(ctx, "deleteWebhook", deleteWebhookBody{DropPendingUpdates: dropPendingUpdates}, nil)
+
+	if err != nil {
+		log.Println("Webhook Wasn't Deleted Successfully, Check Error Logs For Details")
+		return err
+	}
+
+	return nil
+}
+
+// GetWebhookInfo : Fetch The Current Webhook Status
+func (b *Bot) GetWebhookInfo() (WebhookInfo, error) {
+	return b.GetWebhookInfoCtx(context.Background())
+}
+
+// GetWebhookInfoCtx : GetWebhookInfo With A Context
+func (b *Bot) GetWebhookInfoCtx(ctx context.Context) (WebhookInfo, error) {
+	var info WebhookInfo
+
+	err := b.Call(ctx, "getWebhookInfo", nil, &info)
+
+	if err != nil {
+		log.Println("Couldn't Fetch Webhook Info")
+		return WebhookInfo{}, err
+	}
+
+	return info, nil
+}
+
+// SetSecretToken : Require Incoming Webhook Requests To Carry This Token
+// SetWebhook Calls This Automatically With WebhookOptions.SecretToken, Pass An Empty String To Disable The Check
+func (b *Bot) SetSecretToken(token string) {
+	b.webhook.mu.Lock()
+	defer b.webhook.mu.Unlock()
+
+	b.webhook.secretToken = token
+}
+
+// AllowSourceIPs : Only Accept Webhook Requests Coming From The Given CIDR Ranges
+// Use TelegramSubnets For The Ranges Telegram Publishes, Call It With No Arguments To Accept Any Source
+// The Check Is Made Against The Request's RemoteAddr, So Behind A Reverse Proxy Every Request Appears
+// To Come From The Proxy And Is Rejected, Filter On The Proxy Instead In That Case
+func (b *Bot) AllowSourceIPs(cidrs ...string) error {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+
+		if err != nil {
+			return err
+		}
+
+		networks = append(networks, network)
+	}
+
+	b.webhook.mu.Lock()
+	defer b.webhook.mu.Unlock()
+
+	b.webhook.allowedNetworks = networks
+
+	return nil
+}
+
+func (b *Bot) verifyRequest(r *http.Request) bool {
+	if b.webhook == nil {
+		return true
+	}
+
+	b.webhook.mu.RLock()
+	secretToken := b.webhook.secretToken
+	b.webhook.mu.RUnlock()
+
+	if secretToken == "" {
+		return true
+	}
+
+	token := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secretToken)) == 1
+}
+
+func (b *Bot) allowedSource(r *http.Request) bool {
+	if b.webhook == nil {
+		return true
+	}
+
+	b.webhook.mu.RLock()
+	allowedNetworks := b.webhook.allowedNetworks
+	b.webhook.mu.RUnlock()
+
+	if len(allowedNetworks) == 0 {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range allowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
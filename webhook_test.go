@@ -0,0 +1,107 @@
+package goTelegram
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestUpdateHandlerSecurity(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		header     string
+		allow      []string
+		remoteAddr string
+		want       int
+	}{
+		{name: "no checks", want: http.StatusOK},
+		{name: "missing secret", secret: "s3cret", want: http.StatusUnauthorized},
+		{name: "wrong secret", secret: "s3cret", header: "guess", want: http.StatusUnauthorized},
+		{name: "right secret", secret: "s3cret", header: "s3cret", want: http.StatusOK},
+		{name: "source outside the allowlist", allow: TelegramSubnets(), remoteAddr: "203.0.113.9:443", want: http.StatusForbidden},
+		{name: "source inside the allowlist", allow: TelegramSubnets(), remoteAddr: "149.154.167.1:443", want: http.StatusOK},
+		{name: "unparseable source", allow: TelegramSubnets(), remoteAddr: "somewhere", want: http.StatusForbidden},
+		{name: "right secret from outside the allowlist", secret: "s3cret", header: "s3cret", allow: []string{"10.0.0.0/8"}, remoteAddr: "192.0.2.1:1", want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+			bot.SetHandler(func(Update) {})
+			bot.SetSecretToken(tt.secret)
+
+			if err := bot.AllowSourceIPs(tt.allow...); err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"update_id":1}`))
+
+			if tt.header != "" {
+				r.Header.Set("X-Telegram-Bot-Api-Secret-Token", tt.header)
+			}
+
+			if tt.remoteAddr != "" {
+				r.RemoteAddr = tt.remoteAddr
+			}
+
+			recorder := httptest.NewRecorder()
+			bot.UpdateHandler(recorder, r)
+
+			if recorder.Code != tt.want {
+				t.Errorf("UpdateHandler answered %d, want %d", recorder.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowSourceIPsRejectsBadRanges(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+
+	if err := bot.AllowSourceIPs("149.154.160.0/20", "not a range"); err == nil {
+		t.Error("AllowSourceIPs accepted an invalid range")
+	}
+}
+
+func TestTelegramSubnetsIsACopy(t *testing.T) {
+	subnets := TelegramSubnets()
+	subnets[0] = "0.0.0.0/0"
+
+	if TelegramSubnets()[0] == "0.0.0.0/0" {
+		t.Error("changing the returned ranges changed the allowlist")
+	}
+}
+
+func TestWebhookChecksChangeWhileServing(t *testing.T) {
+	bot := newTestBot(t, newFakeAPI(t), BotOptions{})
+	bot.SetHandler(func(Update) {})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				bot.SetSecretToken("token")
+				_ = bot.AllowSourceIPs(TelegramSubnets()...)
+				bot.SetSecretToken("")
+				_ = bot.AllowSourceIPs()
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				postUpdate(bot, `{"update_id":1}`)
+			}
+		}()
+	}
+
+	wg.Wait()
+}